@@ -0,0 +1,17 @@
+package contracts
+
+import _ "embed"
+
+// AddressesJSON is the content of scripts/data/addresses.json, the deployed
+// addresses of every network. It is embedded here because the file is
+// outside of the other Go packages; pkg/addressbook parses it.
+//
+//go:embed scripts/data/addresses.json
+var AddressesJSON []byte
+
+// DeprecatedAddressesJSON is the content of
+// scripts/data/addresses_deprecated.json, which holds the Mumbai deployment
+// and the deprecated Integration contracts.
+//
+//go:embed scripts/data/addresses_deprecated.json
+var DeprecatedAddressesJSON []byte
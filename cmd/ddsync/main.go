@@ -0,0 +1,236 @@
+// Command ddsync brings a manufacturer's device definition table in line with
+// a CSV file in the format of scripts/data/ddInsertInput.csv. It reads the
+// current rows from a Tableland gateway, or from a SQLite stand-in, and sends
+// the deletes, updates and batched inserts that differ.
+//
+//	ddsync -rpc https://polygon-rpc.com -manufacturer 42 -csv dds.csv -plan
+//	DDSYNC_PRIVATE_KEY=... ddsync -rpc https://polygon-rpc.com -manufacturer 42 -csv dds.csv
+//
+// Every transaction sent is waited for and its result logged; the command
+// fails if any of them failed. With -sqlite, the rows are read from the
+// SQLite database instead, and the statement of each transaction is executed
+// on it once the transaction succeeds. Without -rpc, -table names the table
+// otherwise looked up on the registry, so that -plan can run against a
+// stand-in without a node.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/addressbook"
+	"github.com/DIMO-Network/dimo-identity/pkg/devicedefinition"
+	"github.com/DIMO-Network/dimo-identity/pkg/indexer"
+	"github.com/DIMO-Network/dimo-identity/pkg/submitter"
+)
+
+// keyEnv holds the hex private key of the sender.
+const keyEnv = "DDSYNC_PRIVATE_KEY"
+
+func main() {
+	var (
+		rpcURL       = flag.String("rpc", "", "JSON-RPC endpoint of the node")
+		registryAddr = flag.String("registry", "", "registry address, defaults to the address book entry of the node's chain")
+		manufacturer = flag.Int64("manufacturer", 0, "manufacturer token id")
+		csvPath      = flag.String("csv", "", "CSV file of the desired device definitions")
+		gateway      = flag.String("gateway", "", "Tableland gateway URL, defaults to the mainnet or testnet gateway of the node's chain")
+		sqlitePath   = flag.String("sqlite", "", "SQLite stand-in to read the table from instead of a gateway")
+		table        = flag.String("table", "", "table name, defaults to the manufacturer's table on the registry")
+		plan         = flag.Bool("plan", false, "print the changes without sending them")
+		chunk        = flag.Int("chunk", 50, "device definitions per InsertDeviceDefinitionBatch")
+	)
+	flag.Parse()
+
+	if *manufacturer == 0 || *csvPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(context.Background(), options{
+		rpcURL:       *rpcURL,
+		registry:     *registryAddr,
+		manufacturer: big.NewInt(*manufacturer),
+		csvPath:      *csvPath,
+		gateway:      *gateway,
+		sqlitePath:   *sqlitePath,
+		table:        *table,
+		plan:         *plan,
+		chunk:        *chunk,
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type options struct {
+	rpcURL       string
+	registry     string
+	manufacturer *big.Int
+	csvPath      string
+	gateway      string
+	sqlitePath   string
+	table        string
+	plan         bool
+	chunk        int
+}
+
+func run(ctx context.Context, o options) error {
+	f, err := os.Open(o.csvPath)
+	if err != nil {
+		return err
+	}
+	desired, err := devicedefinition.ReadCSV(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", o.csvPath, err)
+	}
+
+	var (
+		client   *ethclient.Client
+		chainID  *big.Int
+		registry *contracts.Registry
+		tableId  *big.Int
+	)
+	if o.rpcURL != "" {
+		if client, err = ethclient.DialContext(ctx, o.rpcURL); err != nil {
+			return err
+		}
+		defer client.Close()
+		if chainID, err = client.ChainID(ctx); err != nil {
+			return err
+		}
+		address, err := registryAddress(o.registry, chainID)
+		if err != nil {
+			return err
+		}
+		if registry, err = contracts.NewRegistry(address, client); err != nil {
+			return err
+		}
+		if tableId, err = registry.GetDeviceDefinitionTableId(&bind.CallOpts{Context: ctx}, o.manufacturer); err != nil {
+			return err
+		}
+		if tableId.Sign() == 0 {
+			return fmt.Errorf("%w: %s", devicedefinition.ErrNoTable, o.manufacturer)
+		}
+		name := devicedefinition.TableName("", chainID, tableId)
+		if o.table != "" && o.table != name {
+			return fmt.Errorf("-table %s is not the manufacturer's table %s", o.table, name)
+		}
+		o.table = name
+	} else if !o.plan || o.table == "" || o.sqlitePath == "" {
+		return fmt.Errorf("-rpc is required unless -plan is used with -sqlite and -table")
+	}
+
+	var (
+		tables  devicedefinition.Tableland
+		standIn *devicedefinition.SQLTableland
+	)
+	switch {
+	case o.sqlitePath != "":
+		db, err := indexer.Open(o.sqlitePath)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		standIn = devicedefinition.NewSQLTableland(db)
+		if err := standIn.CreateTable(ctx, o.table); err != nil {
+			return err
+		}
+		tables = standIn
+	case o.gateway != "":
+		tables = devicedefinition.NewGateway(o.gateway, nil)
+	case chainID.Uint64() == addressbook.PolygonChainID:
+		tables = devicedefinition.NewGateway(devicedefinition.MainnetGatewayURL, nil)
+	default:
+		tables = devicedefinition.NewGateway(devicedefinition.TestnetGatewayURL, nil)
+	}
+
+	current, err := tables.Rows(ctx, o.table)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", o.table, err)
+	}
+	p, err := devicedefinition.Diff(desired, current)
+	if err != nil {
+		return err
+	}
+	if err := p.Validate(&devicedefinition.Validator{}); err != nil {
+		return err
+	}
+	if _, err := p.WriteTo(os.Stdout); err != nil {
+		return err
+	}
+	if o.plan || p.Empty() {
+		return nil
+	}
+
+	key, err := privateKey()
+	if err != nil {
+		return err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+	sub := submitter.New(client, opts, submitter.Config{})
+	txs, err := p.Apply(ctx, &registry.RegistryTransactor, sub, o.manufacturer, o.chunk)
+	errs := []error{err}
+	// Apply sends one transaction per statement of p.SQL, in the same order,
+	// so the stand-in runs the statements of the transactions that succeeded.
+	statements := p.SQL(chainID, tableId, o.chunk)
+	for i, tx := range txs {
+		receipt, werr := bind.WaitMined(ctx, client, tx)
+		switch {
+		case werr != nil:
+			log.Printf("failed to wait for %s: %v", tx.Hash(), werr)
+			errs = append(errs, fmt.Errorf("transaction %s: %w", tx.Hash(), werr))
+			continue
+		case receipt.Status != types.ReceiptStatusSuccessful:
+			log.Printf("%s reverted in block %s", tx.Hash(), receipt.BlockNumber)
+			errs = append(errs, fmt.Errorf("transaction %s reverted", tx.Hash()))
+			continue
+		}
+		log.Printf("mined %s in block %s", tx.Hash(), receipt.BlockNumber)
+		if standIn != nil {
+			if err := standIn.Exec(ctx, statements[i]); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update stand-in: %w", err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func registryAddress(flagValue string, chainID *big.Int) (common.Address, error) {
+	if flagValue != "" {
+		if !common.IsHexAddress(flagValue) {
+			return common.Address{}, fmt.Errorf("invalid registry address %q", flagValue)
+		}
+		return common.HexToAddress(flagValue), nil
+	}
+	n, err := addressbook.ByChainID(chainID.Uint64())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return n.Registry, nil
+}
+
+func privateKey() (*ecdsa.PrivateKey, error) {
+	hex := os.Getenv(keyEnv)
+	if hex == "" {
+		return nil, fmt.Errorf("%s is not set", keyEnv)
+	}
+	if len(hex) > 1 && hex[:2] == "0x" {
+		hex = hex[2:]
+	}
+	return crypto.HexToECDSA(hex)
+}
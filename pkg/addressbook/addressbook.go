@@ -0,0 +1,334 @@
+// Package addressbook exposes the DIMO Identity deployments recorded in
+// scripts/data/addresses.json as typed per-network configuration.
+package addressbook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/aftermarketDeviceId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/dimoForwarder"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/manufacturerId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/syntheticDeviceId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/vehicleId"
+)
+
+// Chain IDs of the networks with a known chain ID.
+const (
+	PolygonChainID uint64 = 137
+	AmoyChainID    uint64 = 80002
+	MumbaiChainID  uint64 = 80001
+)
+
+var chainNetworks = map[uint64]string{
+	PolygonChainID: "polygon",
+	AmoyChainID:    "amoy",
+	MumbaiChainID:  "mumbai",
+}
+
+var (
+	// ErrUnknownNetwork is returned for a network name or chain ID that is
+	// not in the address book.
+	ErrUnknownNetwork = errors.New("addressbook: unknown network")
+	// ErrInvalidAddress is returned for a network whose entries include an
+	// address that is not 40 hex digits with a 0x prefix.
+	ErrInvalidAddress = errors.New("addressbook: invalid address")
+)
+
+// Module is a registry module and the function selectors it serves.
+type Module struct {
+	Address   common.Address
+	Selectors [][4]byte
+}
+
+// Network is the deployment of one network. Deprecated is set for Mumbai,
+// which only lives in addresses_deprecated.json. IntegrationId is the
+// deprecated Integration NFT, zero if it was never deployed. Networks are
+// shared by every caller and must not be modified.
+type Network struct {
+	Name       string
+	ChainID    uint64
+	Deprecated bool
+
+	Registry            common.Address
+	ManufacturerId      common.Address
+	VehicleId           common.Address
+	AftermarketDeviceId common.Address
+	SyntheticDeviceId   common.Address
+	IntegrationId       common.Address
+	DimoForwarder       common.Address
+
+	DimoToken          common.Address
+	DimoCredit         common.Address
+	Foundation         common.Address
+	Kms                []common.Address
+	TablelandTables    common.Address
+	Sacd               common.Address
+	ConnectionsManager common.Address
+	StorageNode        common.Address
+
+	// Modules maps module names, such as "Vehicle" or "DevAdmin", to their
+	// address and selectors.
+	Modules map[string]Module
+}
+
+// addressJSON is an address that may be empty in the JSON files, for modules
+// that are not deployed on a network. Anything else must be 40 hex digits
+// with a 0x prefix; a mistyped entry is kept in invalid instead of being
+// truncated, and its network fails to load.
+type addressJSON struct {
+	common.Address
+	invalid string
+}
+
+func (a *addressJSON) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*a = addressJSON{}
+	switch {
+	case s == "":
+	case !has0xPrefix(s) || !common.IsHexAddress(s):
+		a.invalid = s
+	default:
+		a.Address = common.HexToAddress(s)
+	}
+	return nil
+}
+
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
+type proxyJSON struct {
+	Proxy addressJSON `json:"proxy"`
+}
+
+type moduleJSON struct {
+	Address   addressJSON     `json:"address"`
+	Selectors []hexutil.Bytes `json:"selectors"`
+}
+
+type networkJSON struct {
+	Modules map[string]moduleJSON `json:"modules"`
+	NFTs    map[string]proxyJSON  `json:"nfts"`
+	Misc    struct {
+		DimoToken          proxyJSON     `json:"DimoToken"`
+		DimoCredit         proxyJSON     `json:"DimoCredit"`
+		DimoForwarder      proxyJSON     `json:"DimoForwarder"`
+		Foundation         addressJSON   `json:"Foundation"`
+		Kms                []addressJSON `json:"Kms"`
+		TablelandTables    addressJSON   `json:"TablelandTables"`
+		Sacd               addressJSON   `json:"Sacd"`
+		ConnectionsManager addressJSON   `json:"ConnectionsManager"`
+		StorageNode        addressJSON   `json:"StorageNode"`
+	} `json:"misc"`
+}
+
+var (
+	loadOnce sync.Once
+	networks map[string]*Network
+	// invalid holds the error of the networks with a malformed entry.
+	invalid map[string]error
+	loadErr error
+)
+
+func load() (map[string]*Network, error) {
+	loadOnce.Do(func() {
+		var current, deprecated map[string]networkJSON
+		if loadErr = json.Unmarshal(contracts.AddressesJSON, &current); loadErr != nil {
+			loadErr = fmt.Errorf("failed to parse addresses.json: %w", loadErr)
+			return
+		}
+		if loadErr = json.Unmarshal(contracts.DeprecatedAddressesJSON, &deprecated); loadErr != nil {
+			loadErr = fmt.Errorf("failed to parse addresses_deprecated.json: %w", loadErr)
+			return
+		}
+
+		networks = make(map[string]*Network)
+		invalid = make(map[string]error)
+		add := func(name string, raw networkJSON, deprecated bool) {
+			n, err := newNetwork(name, raw, deprecated)
+			networks[name] = n
+			if err != nil {
+				invalid[name] = err
+			}
+		}
+		for name, raw := range current {
+			add(name, raw, false)
+		}
+		for name, raw := range deprecated {
+			n, ok := networks[name]
+			if !ok {
+				add(name, raw, true)
+				continue
+			}
+			p := addressParser{network: name}
+			if n != nil {
+				n.IntegrationId = p.parse("nfts.IntegrationId", raw.NFTs["IntegrationId"].Proxy)
+			}
+			if p.err != nil && invalid[name] == nil {
+				invalid[name] = p.err
+			}
+		}
+	})
+	return networks, loadErr
+}
+
+// addressParser converts the addresses of a network and keeps the first
+// malformed one as err.
+type addressParser struct {
+	network string
+	err     error
+}
+
+func (p *addressParser) parse(field string, a addressJSON) common.Address {
+	if a.invalid != "" && p.err == nil {
+		p.err = fmt.Errorf("%w %q: %s %s", ErrInvalidAddress, a.invalid, p.network, field)
+	}
+	return a.Address
+}
+
+func newNetwork(name string, raw networkJSON, deprecated bool) (*Network, error) {
+	p := addressParser{network: name}
+	n := &Network{
+		Name:       name,
+		Deprecated: deprecated,
+
+		Registry:            p.parse("modules.DIMORegistry", raw.Modules["DIMORegistry"].Address),
+		ManufacturerId:      p.parse("nfts.ManufacturerId", raw.NFTs["ManufacturerId"].Proxy),
+		VehicleId:           p.parse("nfts.VehicleId", raw.NFTs["VehicleId"].Proxy),
+		AftermarketDeviceId: p.parse("nfts.AftermarketDeviceId", raw.NFTs["AftermarketDeviceId"].Proxy),
+		SyntheticDeviceId:   p.parse("nfts.SyntheticDeviceId", raw.NFTs["SyntheticDeviceId"].Proxy),
+		IntegrationId:       p.parse("nfts.IntegrationId", raw.NFTs["IntegrationId"].Proxy),
+		DimoForwarder:       p.parse("misc.DimoForwarder", raw.Misc.DimoForwarder.Proxy),
+
+		DimoToken:          p.parse("misc.DimoToken", raw.Misc.DimoToken.Proxy),
+		DimoCredit:         p.parse("misc.DimoCredit", raw.Misc.DimoCredit.Proxy),
+		Foundation:         p.parse("misc.Foundation", raw.Misc.Foundation),
+		TablelandTables:    p.parse("misc.TablelandTables", raw.Misc.TablelandTables),
+		Sacd:               p.parse("misc.Sacd", raw.Misc.Sacd),
+		ConnectionsManager: p.parse("misc.ConnectionsManager", raw.Misc.ConnectionsManager),
+		StorageNode:        p.parse("misc.StorageNode", raw.Misc.StorageNode),
+
+		Modules: make(map[string]Module, len(raw.Modules)),
+	}
+	for i, kms := range raw.Misc.Kms {
+		n.Kms = append(n.Kms, p.parse(fmt.Sprintf("misc.Kms[%d]", i), kms))
+	}
+	for id, network := range chainNetworks {
+		if network == name {
+			n.ChainID = id
+		}
+	}
+
+	for moduleName, m := range raw.Modules {
+		module := Module{Address: p.parse("modules."+moduleName, m.Address), Selectors: make([][4]byte, len(m.Selectors))}
+		for i, sel := range m.Selectors {
+			if len(sel) != 4 {
+				return nil, fmt.Errorf("%s: module %s has invalid selector %s", name, moduleName, sel)
+			}
+			copy(module.Selectors[i][:], sel)
+		}
+		n.Modules[moduleName] = module
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return n, nil
+}
+
+// Networks returns the names of every network in the address book, sorted.
+func Networks() ([]string, error) {
+	all, err := load()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ByName returns the deployment of a network by its name in addresses.json,
+// e.g. "polygon", "amoy", "mumbai" or "hardhat". A network with a malformed
+// address fails with ErrInvalidAddress, naming the entry.
+func ByName(name string) (*Network, error) {
+	all, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if err := invalid[name]; err != nil {
+		return nil, err
+	}
+	n, ok := all[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNetwork, name)
+	}
+	return n, nil
+}
+
+// ByChainID returns the deployment of Polygon, Amoy or Mumbai by chain ID.
+func ByChainID(chainID uint64) (*Network, error) {
+	name, ok := chainNetworks[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: chain id %d", ErrUnknownNetwork, chainID)
+	}
+	return ByName(name)
+}
+
+// Contracts are the bindings of a network's deployment.
+type Contracts struct {
+	Network             *Network
+	Registry            *contracts.Registry
+	ManufacturerId      *manufacturerId.ManufacturerId
+	VehicleId           *vehicleId.VehicleId
+	AftermarketDeviceId *aftermarketDeviceId.AftermarketDeviceId
+	SyntheticDeviceId   *syntheticDeviceId.SyntheticDeviceId
+	DimoForwarder       *dimoForwarder.DimoForwarder
+}
+
+// Connect returns the bindings of the network with chainID over backend.
+func Connect(chainID uint64, backend bind.ContractBackend) (*Contracts, error) {
+	n, err := ByChainID(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return n.Connect(backend)
+}
+
+// Connect returns the bindings of the network's deployment over backend.
+func (n *Network) Connect(backend bind.ContractBackend) (*Contracts, error) {
+	c := &Contracts{Network: n}
+	var err error
+	if c.Registry, err = contracts.NewRegistry(n.Registry, backend); err != nil {
+		return nil, err
+	}
+	if c.ManufacturerId, err = manufacturerId.NewManufacturerId(n.ManufacturerId, backend); err != nil {
+		return nil, err
+	}
+	if c.VehicleId, err = vehicleId.NewVehicleId(n.VehicleId, backend); err != nil {
+		return nil, err
+	}
+	if c.AftermarketDeviceId, err = aftermarketDeviceId.NewAftermarketDeviceId(n.AftermarketDeviceId, backend); err != nil {
+		return nil, err
+	}
+	if c.SyntheticDeviceId, err = syntheticDeviceId.NewSyntheticDeviceId(n.SyntheticDeviceId, backend); err != nil {
+		return nil, err
+	}
+	if c.DimoForwarder, err = dimoForwarder.NewDimoForwarder(n.DimoForwarder, backend); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,111 @@
+package addressbook
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestAddressJSON(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    common.Address
+		invalid bool
+	}{
+		{in: `""`},
+		{in: `"0xFA8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC"`, want: common.HexToAddress("0xFA8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC")},
+		{in: `"0xfa8bec73cebb9d88ff88a2f75e7d7312f2fd39ec"`, want: common.HexToAddress("0xFA8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC")},
+		{in: `"FA8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC"`, invalid: true},
+		{in: `"0x00000000000000000000000000000000000000003"`, invalid: true},
+		{in: `"0x000000000000000000000000000000000000003"`, invalid: true},
+		{in: `"0xFA8beC73cebB9D88FF88a2f75E7D7312f2Fd39E"`, invalid: true},
+		{in: `"0xZZ8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC"`, invalid: true},
+		{in: `"0x"`, invalid: true},
+	} {
+		var got addressJSON
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s) = %v", tt.in, err)
+			continue
+		}
+		if got.Address != tt.want || (got.invalid != "") != tt.invalid {
+			t.Errorf("Unmarshal(%s) = %s, invalid %q, want %s, invalid %t", tt.in, got.Address, got.invalid, tt.want, tt.invalid)
+		}
+	}
+
+	var got addressJSON
+	if err := json.Unmarshal([]byte(`1`), &got); err == nil {
+		t.Error("Unmarshal(1) succeeded, want an error")
+	}
+}
+
+func TestNewNetworkInvalidAddress(t *testing.T) {
+	var raw networkJSON
+	if err := json.Unmarshal([]byte(`{"misc": {"Kms": ["0xcce4eF41A67E28C3CF3dbc51a6CD3d004F53aCBd", "0x123"]}}`), &raw); err != nil {
+		t.Fatal(err)
+	}
+	_, err := newNetwork("polygon", raw, false)
+	if !errors.Is(err, ErrInvalidAddress) || !strings.Contains(err.Error(), `"0x123": polygon misc.Kms[1]`) {
+		t.Fatalf("newNetwork() = %v, want %v naming polygon misc.Kms[1]", err, ErrInvalidAddress)
+	}
+}
+
+func TestNetworks(t *testing.T) {
+	names, err := Networks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(names, ","), "amoy,hardhat,localhost,mumbai,polygon"; got != want {
+		t.Errorf("Networks() = %s, want %s", got, want)
+	}
+}
+
+func TestByChainID(t *testing.T) {
+	polygon, err := ByChainID(PolygonChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		got  common.Address
+		want string
+	}{
+		{"Registry", polygon.Registry, "0xFA8beC73cebB9D88FF88a2f75E7D7312f2Fd39EC"},
+		{"VehicleId", polygon.VehicleId, "0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF"},
+		{"IntegrationId", polygon.IntegrationId, "0xf41cE833C1A35C80eB5Feb69e23F1Ed2ECBE20e0"},
+		{"Kms[0]", polygon.Kms[0], "0xcce4eF41A67E28C3CF3dbc51a6CD3d004F53aCBd"},
+	} {
+		if tt.got != common.HexToAddress(tt.want) {
+			t.Errorf("polygon %s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+	if polygon.Name != "polygon" || polygon.ChainID != PolygonChainID || polygon.Deprecated || len(polygon.Kms) != 3 {
+		t.Errorf("polygon = %s, chain %d, deprecated %t, %d KMS, want polygon, 137, false, 3", polygon.Name, polygon.ChainID, polygon.Deprecated, len(polygon.Kms))
+	}
+	vehicle, ok := polygon.Modules["Vehicle"]
+	if !ok || len(vehicle.Selectors) == 0 || vehicle.Address == (common.Address{}) {
+		t.Errorf("polygon Vehicle module = %+v, want an address and selectors", vehicle)
+	}
+
+	amoy, err := ByChainID(AmoyChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amoy.Name != "amoy" || amoy.Deprecated || amoy.Registry == (common.Address{}) {
+		t.Errorf("amoy = %s, deprecated %t, registry %s, want amoy with a registry", amoy.Name, amoy.Deprecated, amoy.Registry)
+	}
+
+	// addresses_deprecated.json has 41 hex digits for Mumbai's Tableland.
+	if _, err := ByChainID(MumbaiChainID); !errors.Is(err, ErrInvalidAddress) || !strings.Contains(err.Error(), "mumbai misc.TablelandTables") {
+		t.Errorf("ByChainID(mumbai) = %v, want %v naming mumbai misc.TablelandTables", err, ErrInvalidAddress)
+	}
+
+	if _, err := ByChainID(1); !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("ByChainID(1) = %v, want %v", err, ErrUnknownNetwork)
+	}
+	if _, err := ByName("sepolia"); !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("ByName(sepolia) = %v, want %v", err, ErrUnknownNetwork)
+	}
+}
@@ -0,0 +1,1796 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package dimoForwarder
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// DimoForwarderMetaData contains all meta data concerning the DimoForwarder contract.
+var DimoForwarderMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"idProxySource\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"idProxyTraget\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"sourceId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"targetId\",\"type\":\"uint256\"}],\"name\":\"InvalidLink\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"idProxy\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"errorMessage\",\"type\":\"string\"}],\"name\":\"TransferFailed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SAFE_TRANSFER_FROM\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"adIdProxyAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"dimoRegistry\",\"outputs\":[{\"internalType\":\"contractIDimoRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"vehicleIdProxyAddress_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"adIdProxyAddress_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"adIdProxyAddress_\",\"type\":\"address\"}],\"name\":\"setAftermarketDeviceIdProxyAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"}],\"name\":\"setDimoRegistryAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"vehicleIdProxyAddress_\",\"type\":\"address\"}],\"name\":\"setVehicleIdProxyAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"vehicleIds\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"aftermarketDeviceIds\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"transferVehicleAndAftermarketDeviceIds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"vehicleId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"aftermarketDeviceId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"transferVehicleAndAftermarketDeviceIds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"vehicleIdProxyAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// DimoForwarderABI is the input ABI used to generate the binding from.
+// Deprecated: Use DimoForwarderMetaData.ABI instead.
+var DimoForwarderABI = DimoForwarderMetaData.ABI
+
+// DimoForwarder is an auto generated Go binding around an Ethereum contract.
+type DimoForwarder struct {
+	DimoForwarderCaller     // Read-only binding to the contract
+	DimoForwarderTransactor // Write-only binding to the contract
+	DimoForwarderFilterer   // Log filterer for contract events
+}
+
+// DimoForwarderCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DimoForwarderCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DimoForwarderTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DimoForwarderTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DimoForwarderFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DimoForwarderFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DimoForwarderSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DimoForwarderSession struct {
+	Contract     *DimoForwarder    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DimoForwarderCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DimoForwarderCallerSession struct {
+	Contract *DimoForwarderCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// DimoForwarderTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DimoForwarderTransactorSession struct {
+	Contract     *DimoForwarderTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// DimoForwarderRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DimoForwarderRaw struct {
+	Contract *DimoForwarder // Generic contract binding to access the raw methods on
+}
+
+// DimoForwarderCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DimoForwarderCallerRaw struct {
+	Contract *DimoForwarderCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DimoForwarderTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DimoForwarderTransactorRaw struct {
+	Contract *DimoForwarderTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDimoForwarder creates a new instance of DimoForwarder, bound to a specific deployed contract.
+func NewDimoForwarder(address common.Address, backend bind.ContractBackend) (*DimoForwarder, error) {
+	contract, err := bindDimoForwarder(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarder{DimoForwarderCaller: DimoForwarderCaller{contract: contract}, DimoForwarderTransactor: DimoForwarderTransactor{contract: contract}, DimoForwarderFilterer: DimoForwarderFilterer{contract: contract}}, nil
+}
+
+// NewDimoForwarderCaller creates a new read-only instance of DimoForwarder, bound to a specific deployed contract.
+func NewDimoForwarderCaller(address common.Address, caller bind.ContractCaller) (*DimoForwarderCaller, error) {
+	contract, err := bindDimoForwarder(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderCaller{contract: contract}, nil
+}
+
+// NewDimoForwarderTransactor creates a new write-only instance of DimoForwarder, bound to a specific deployed contract.
+func NewDimoForwarderTransactor(address common.Address, transactor bind.ContractTransactor) (*DimoForwarderTransactor, error) {
+	contract, err := bindDimoForwarder(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderTransactor{contract: contract}, nil
+}
+
+// NewDimoForwarderFilterer creates a new log filterer instance of DimoForwarder, bound to a specific deployed contract.
+func NewDimoForwarderFilterer(address common.Address, filterer bind.ContractFilterer) (*DimoForwarderFilterer, error) {
+	contract, err := bindDimoForwarder(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderFilterer{contract: contract}, nil
+}
+
+// bindDimoForwarder binds a generic wrapper to an already deployed contract.
+func bindDimoForwarder(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DimoForwarderMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DimoForwarder *DimoForwarderRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DimoForwarder.Contract.DimoForwarderCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DimoForwarder *DimoForwarderRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.DimoForwarderTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DimoForwarder *DimoForwarderRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.DimoForwarderTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DimoForwarder *DimoForwarderCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DimoForwarder.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DimoForwarder *DimoForwarderTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DimoForwarder *DimoForwarderTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderSession) ADMINROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.ADMINROLE(&_DimoForwarder.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCallerSession) ADMINROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.ADMINROLE(&_DimoForwarder.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.DEFAULTADMINROLE(&_DimoForwarder.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.DEFAULTADMINROLE(&_DimoForwarder.CallOpts)
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_DimoForwarder *DimoForwarderCaller) SAFETRANSFERFROM(opts *bind.CallOpts) ([4]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "SAFE_TRANSFER_FROM")
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_DimoForwarder *DimoForwarderSession) SAFETRANSFERFROM() ([4]byte, error) {
+	return _DimoForwarder.Contract.SAFETRANSFERFROM(&_DimoForwarder.CallOpts)
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_DimoForwarder *DimoForwarderCallerSession) SAFETRANSFERFROM() ([4]byte, error) {
+	return _DimoForwarder.Contract.SAFETRANSFERFROM(&_DimoForwarder.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderSession) UPGRADERROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.UPGRADERROLE(&_DimoForwarder.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _DimoForwarder.Contract.UPGRADERROLE(&_DimoForwarder.CallOpts)
+}
+
+// AdIdProxyAddress is a free data retrieval call binding the contract method 0xb1ba0e45.
+//
+// Solidity: function adIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderCaller) AdIdProxyAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "adIdProxyAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AdIdProxyAddress is a free data retrieval call binding the contract method 0xb1ba0e45.
+//
+// Solidity: function adIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderSession) AdIdProxyAddress() (common.Address, error) {
+	return _DimoForwarder.Contract.AdIdProxyAddress(&_DimoForwarder.CallOpts)
+}
+
+// AdIdProxyAddress is a free data retrieval call binding the contract method 0xb1ba0e45.
+//
+// Solidity: function adIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderCallerSession) AdIdProxyAddress() (common.Address, error) {
+	return _DimoForwarder.Contract.AdIdProxyAddress(&_DimoForwarder.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_DimoForwarder *DimoForwarderCaller) DimoRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "dimoRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_DimoForwarder *DimoForwarderSession) DimoRegistry() (common.Address, error) {
+	return _DimoForwarder.Contract.DimoRegistry(&_DimoForwarder.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_DimoForwarder *DimoForwarderCallerSession) DimoRegistry() (common.Address, error) {
+	return _DimoForwarder.Contract.DimoRegistry(&_DimoForwarder.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_DimoForwarder *DimoForwarderSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _DimoForwarder.Contract.GetRoleAdmin(&_DimoForwarder.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _DimoForwarder.Contract.GetRoleAdmin(&_DimoForwarder.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_DimoForwarder *DimoForwarderCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_DimoForwarder *DimoForwarderSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _DimoForwarder.Contract.HasRole(&_DimoForwarder.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_DimoForwarder *DimoForwarderCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _DimoForwarder.Contract.HasRole(&_DimoForwarder.CallOpts, role, account)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderSession) ProxiableUUID() ([32]byte, error) {
+	return _DimoForwarder.Contract.ProxiableUUID(&_DimoForwarder.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_DimoForwarder *DimoForwarderCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _DimoForwarder.Contract.ProxiableUUID(&_DimoForwarder.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_DimoForwarder *DimoForwarderCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_DimoForwarder *DimoForwarderSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _DimoForwarder.Contract.SupportsInterface(&_DimoForwarder.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_DimoForwarder *DimoForwarderCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _DimoForwarder.Contract.SupportsInterface(&_DimoForwarder.CallOpts, interfaceId)
+}
+
+// VehicleIdProxyAddress is a free data retrieval call binding the contract method 0x8823467a.
+//
+// Solidity: function vehicleIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderCaller) VehicleIdProxyAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DimoForwarder.contract.Call(opts, &out, "vehicleIdProxyAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VehicleIdProxyAddress is a free data retrieval call binding the contract method 0x8823467a.
+//
+// Solidity: function vehicleIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderSession) VehicleIdProxyAddress() (common.Address, error) {
+	return _DimoForwarder.Contract.VehicleIdProxyAddress(&_DimoForwarder.CallOpts)
+}
+
+// VehicleIdProxyAddress is a free data retrieval call binding the contract method 0x8823467a.
+//
+// Solidity: function vehicleIdProxyAddress() view returns(address)
+func (_DimoForwarder *DimoForwarderCallerSession) VehicleIdProxyAddress() (common.Address, error) {
+	return _DimoForwarder.Contract.VehicleIdProxyAddress(&_DimoForwarder.CallOpts)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.GrantRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.GrantRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address dimoRegistry_, address vehicleIdProxyAddress_, address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactor) Initialize(opts *bind.TransactOpts, dimoRegistry_ common.Address, vehicleIdProxyAddress_ common.Address, adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "initialize", dimoRegistry_, vehicleIdProxyAddress_, adIdProxyAddress_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address dimoRegistry_, address vehicleIdProxyAddress_, address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderSession) Initialize(dimoRegistry_ common.Address, vehicleIdProxyAddress_ common.Address, adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.Initialize(&_DimoForwarder.TransactOpts, dimoRegistry_, vehicleIdProxyAddress_, adIdProxyAddress_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc0c53b8b.
+//
+// Solidity: function initialize(address dimoRegistry_, address vehicleIdProxyAddress_, address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) Initialize(dimoRegistry_ common.Address, vehicleIdProxyAddress_ common.Address, adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.Initialize(&_DimoForwarder.TransactOpts, dimoRegistry_, vehicleIdProxyAddress_, adIdProxyAddress_)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.RenounceRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.RenounceRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.RevokeRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.RevokeRole(&_DimoForwarder.TransactOpts, role, account)
+}
+
+// SetAftermarketDeviceIdProxyAddress is a paid mutator transaction binding the contract method 0x4d49d82a.
+//
+// Solidity: function setAftermarketDeviceIdProxyAddress(address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactor) SetAftermarketDeviceIdProxyAddress(opts *bind.TransactOpts, adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "setAftermarketDeviceIdProxyAddress", adIdProxyAddress_)
+}
+
+// SetAftermarketDeviceIdProxyAddress is a paid mutator transaction binding the contract method 0x4d49d82a.
+//
+// Solidity: function setAftermarketDeviceIdProxyAddress(address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderSession) SetAftermarketDeviceIdProxyAddress(adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetAftermarketDeviceIdProxyAddress(&_DimoForwarder.TransactOpts, adIdProxyAddress_)
+}
+
+// SetAftermarketDeviceIdProxyAddress is a paid mutator transaction binding the contract method 0x4d49d82a.
+//
+// Solidity: function setAftermarketDeviceIdProxyAddress(address adIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) SetAftermarketDeviceIdProxyAddress(adIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetAftermarketDeviceIdProxyAddress(&_DimoForwarder.TransactOpts, adIdProxyAddress_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_DimoForwarder *DimoForwarderTransactor) SetDimoRegistryAddress(opts *bind.TransactOpts, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "setDimoRegistryAddress", dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_DimoForwarder *DimoForwarderSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetDimoRegistryAddress(&_DimoForwarder.TransactOpts, dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetDimoRegistryAddress(&_DimoForwarder.TransactOpts, dimoRegistry_)
+}
+
+// SetVehicleIdProxyAddress is a paid mutator transaction binding the contract method 0x9bfae6da.
+//
+// Solidity: function setVehicleIdProxyAddress(address vehicleIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactor) SetVehicleIdProxyAddress(opts *bind.TransactOpts, vehicleIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "setVehicleIdProxyAddress", vehicleIdProxyAddress_)
+}
+
+// SetVehicleIdProxyAddress is a paid mutator transaction binding the contract method 0x9bfae6da.
+//
+// Solidity: function setVehicleIdProxyAddress(address vehicleIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderSession) SetVehicleIdProxyAddress(vehicleIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetVehicleIdProxyAddress(&_DimoForwarder.TransactOpts, vehicleIdProxyAddress_)
+}
+
+// SetVehicleIdProxyAddress is a paid mutator transaction binding the contract method 0x9bfae6da.
+//
+// Solidity: function setVehicleIdProxyAddress(address vehicleIdProxyAddress_) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) SetVehicleIdProxyAddress(vehicleIdProxyAddress_ common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.SetVehicleIdProxyAddress(&_DimoForwarder.TransactOpts, vehicleIdProxyAddress_)
+}
+
+// TransferVehicleAndAftermarketDeviceIds is a paid mutator transaction binding the contract method 0x3cdd8326.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256[] vehicleIds, uint256[] aftermarketDeviceIds, address to) returns()
+func (_DimoForwarder *DimoForwarderTransactor) TransferVehicleAndAftermarketDeviceIds(opts *bind.TransactOpts, vehicleIds []*big.Int, aftermarketDeviceIds []*big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "transferVehicleAndAftermarketDeviceIds", vehicleIds, aftermarketDeviceIds, to)
+}
+
+// TransferVehicleAndAftermarketDeviceIds is a paid mutator transaction binding the contract method 0x3cdd8326.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256[] vehicleIds, uint256[] aftermarketDeviceIds, address to) returns()
+func (_DimoForwarder *DimoForwarderSession) TransferVehicleAndAftermarketDeviceIds(vehicleIds []*big.Int, aftermarketDeviceIds []*big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.TransferVehicleAndAftermarketDeviceIds(&_DimoForwarder.TransactOpts, vehicleIds, aftermarketDeviceIds, to)
+}
+
+// TransferVehicleAndAftermarketDeviceIds is a paid mutator transaction binding the contract method 0x3cdd8326.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256[] vehicleIds, uint256[] aftermarketDeviceIds, address to) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) TransferVehicleAndAftermarketDeviceIds(vehicleIds []*big.Int, aftermarketDeviceIds []*big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.TransferVehicleAndAftermarketDeviceIds(&_DimoForwarder.TransactOpts, vehicleIds, aftermarketDeviceIds, to)
+}
+
+// TransferVehicleAndAftermarketDeviceIds0 is a paid mutator transaction binding the contract method 0x6d2f54de.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256 vehicleId, uint256 aftermarketDeviceId, address to) returns()
+func (_DimoForwarder *DimoForwarderTransactor) TransferVehicleAndAftermarketDeviceIds0(opts *bind.TransactOpts, vehicleId *big.Int, aftermarketDeviceId *big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "transferVehicleAndAftermarketDeviceIds0", vehicleId, aftermarketDeviceId, to)
+}
+
+// TransferVehicleAndAftermarketDeviceIds0 is a paid mutator transaction binding the contract method 0x6d2f54de.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256 vehicleId, uint256 aftermarketDeviceId, address to) returns()
+func (_DimoForwarder *DimoForwarderSession) TransferVehicleAndAftermarketDeviceIds0(vehicleId *big.Int, aftermarketDeviceId *big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.TransferVehicleAndAftermarketDeviceIds0(&_DimoForwarder.TransactOpts, vehicleId, aftermarketDeviceId, to)
+}
+
+// TransferVehicleAndAftermarketDeviceIds0 is a paid mutator transaction binding the contract method 0x6d2f54de.
+//
+// Solidity: function transferVehicleAndAftermarketDeviceIds(uint256 vehicleId, uint256 aftermarketDeviceId, address to) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) TransferVehicleAndAftermarketDeviceIds0(vehicleId *big.Int, aftermarketDeviceId *big.Int, to common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.TransferVehicleAndAftermarketDeviceIds0(&_DimoForwarder.TransactOpts, vehicleId, aftermarketDeviceId, to)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_DimoForwarder *DimoForwarderTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_DimoForwarder *DimoForwarderSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.UpgradeTo(&_DimoForwarder.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.UpgradeTo(&_DimoForwarder.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_DimoForwarder *DimoForwarderTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _DimoForwarder.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_DimoForwarder *DimoForwarderSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.UpgradeToAndCall(&_DimoForwarder.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_DimoForwarder *DimoForwarderTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _DimoForwarder.Contract.UpgradeToAndCall(&_DimoForwarder.TransactOpts, newImplementation, data)
+}
+
+// DimoForwarderAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the DimoForwarder contract.
+type DimoForwarderAdminChangedIterator struct {
+	Event *DimoForwarderAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderAdminChanged represents a AdminChanged event raised by the DimoForwarder contract.
+type DimoForwarderAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_DimoForwarder *DimoForwarderFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*DimoForwarderAdminChangedIterator, error) {
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderAdminChangedIterator{contract: _DimoForwarder.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_DimoForwarder *DimoForwarderFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *DimoForwarderAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderAdminChanged)
+				if err := _DimoForwarder.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_DimoForwarder *DimoForwarderFilterer) ParseAdminChanged(log types.Log) (*DimoForwarderAdminChanged, error) {
+	event := new(DimoForwarderAdminChanged)
+	if err := _DimoForwarder.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the DimoForwarder contract.
+type DimoForwarderBeaconUpgradedIterator struct {
+	Event *DimoForwarderBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderBeaconUpgraded represents a BeaconUpgraded event raised by the DimoForwarder contract.
+type DimoForwarderBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_DimoForwarder *DimoForwarderFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*DimoForwarderBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderBeaconUpgradedIterator{contract: _DimoForwarder.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_DimoForwarder *DimoForwarderFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *DimoForwarderBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderBeaconUpgraded)
+				if err := _DimoForwarder.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_DimoForwarder *DimoForwarderFilterer) ParseBeaconUpgraded(log types.Log) (*DimoForwarderBeaconUpgraded, error) {
+	event := new(DimoForwarderBeaconUpgraded)
+	if err := _DimoForwarder.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the DimoForwarder contract.
+type DimoForwarderInitializedIterator struct {
+	Event *DimoForwarderInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderInitialized represents a Initialized event raised by the DimoForwarder contract.
+type DimoForwarderInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DimoForwarder *DimoForwarderFilterer) FilterInitialized(opts *bind.FilterOpts) (*DimoForwarderInitializedIterator, error) {
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderInitializedIterator{contract: _DimoForwarder.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DimoForwarder *DimoForwarderFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *DimoForwarderInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderInitialized)
+				if err := _DimoForwarder.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_DimoForwarder *DimoForwarderFilterer) ParseInitialized(log types.Log) (*DimoForwarderInitialized, error) {
+	event := new(DimoForwarderInitialized)
+	if err := _DimoForwarder.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the DimoForwarder contract.
+type DimoForwarderRoleAdminChangedIterator struct {
+	Event *DimoForwarderRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderRoleAdminChanged represents a RoleAdminChanged event raised by the DimoForwarder contract.
+type DimoForwarderRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_DimoForwarder *DimoForwarderFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*DimoForwarderRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderRoleAdminChangedIterator{contract: _DimoForwarder.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_DimoForwarder *DimoForwarderFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *DimoForwarderRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderRoleAdminChanged)
+				if err := _DimoForwarder.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_DimoForwarder *DimoForwarderFilterer) ParseRoleAdminChanged(log types.Log) (*DimoForwarderRoleAdminChanged, error) {
+	event := new(DimoForwarderRoleAdminChanged)
+	if err := _DimoForwarder.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the DimoForwarder contract.
+type DimoForwarderRoleGrantedIterator struct {
+	Event *DimoForwarderRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderRoleGranted represents a RoleGranted event raised by the DimoForwarder contract.
+type DimoForwarderRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*DimoForwarderRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderRoleGrantedIterator{contract: _DimoForwarder.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *DimoForwarderRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderRoleGranted)
+				if err := _DimoForwarder.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) ParseRoleGranted(log types.Log) (*DimoForwarderRoleGranted, error) {
+	event := new(DimoForwarderRoleGranted)
+	if err := _DimoForwarder.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the DimoForwarder contract.
+type DimoForwarderRoleRevokedIterator struct {
+	Event *DimoForwarderRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderRoleRevoked represents a RoleRevoked event raised by the DimoForwarder contract.
+type DimoForwarderRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*DimoForwarderRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderRoleRevokedIterator{contract: _DimoForwarder.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *DimoForwarderRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderRoleRevoked)
+				if err := _DimoForwarder.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_DimoForwarder *DimoForwarderFilterer) ParseRoleRevoked(log types.Log) (*DimoForwarderRoleRevoked, error) {
+	event := new(DimoForwarderRoleRevoked)
+	if err := _DimoForwarder.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DimoForwarderUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the DimoForwarder contract.
+type DimoForwarderUpgradedIterator struct {
+	Event *DimoForwarderUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DimoForwarderUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DimoForwarderUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DimoForwarderUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DimoForwarderUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DimoForwarderUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DimoForwarderUpgraded represents a Upgraded event raised by the DimoForwarder contract.
+type DimoForwarderUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_DimoForwarder *DimoForwarderFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*DimoForwarderUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DimoForwarderUpgradedIterator{contract: _DimoForwarder.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_DimoForwarder *DimoForwarderFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *DimoForwarderUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _DimoForwarder.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DimoForwarderUpgraded)
+				if err := _DimoForwarder.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_DimoForwarder *DimoForwarderFilterer) ParseUpgraded(log types.Log) (*DimoForwarderUpgraded, error) {
+	event := new(DimoForwarderUpgraded)
+	if err := _DimoForwarder.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3830 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package integrationId
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MultiPrivilegeSetPrivilegeData is an auto generated low-level Go binding around an user-defined struct.
+type MultiPrivilegeSetPrivilegeData struct {
+	TokenId *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+}
+
+// IntegrationIdMetaData contains all meta data concerning the IntegrationId contract.
+var IntegrationIdMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"PrivilegeCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"PrivilegeSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFERER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"createPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"dimoRegistry\",\"outputs\":[{\"internalType\":\"contractIDimoRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"disablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"enablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"hasPrivilege\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseUri_\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"trustedForwarders_\",\"type\":\"address[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"privilegeEntry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"privilegeExpiresAt\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"privilegeRecord\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI_\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"}],\"name\":\"setDimoRegistryAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"setPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"internalType\":\"structMultiPrivilege.SetPrivilegeData[]\",\"name\":\"privData\",\"type\":\"tuple[]\"}],\"name\":\"setPrivileges\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"trusted\",\"type\":\"bool\"}],\"name\":\"setTrustedForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenIdToVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"trustedForwarders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// IntegrationIdABI is the input ABI used to generate the binding from.
+// Deprecated: Use IntegrationIdMetaData.ABI instead.
+var IntegrationIdABI = IntegrationIdMetaData.ABI
+
+// IntegrationId is an auto generated Go binding around an Ethereum contract.
+type IntegrationId struct {
+	IntegrationIdCaller     // Read-only binding to the contract
+	IntegrationIdTransactor // Write-only binding to the contract
+	IntegrationIdFilterer   // Log filterer for contract events
+}
+
+// IntegrationIdCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IntegrationIdCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IntegrationIdTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IntegrationIdTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IntegrationIdFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IntegrationIdFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IntegrationIdSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IntegrationIdSession struct {
+	Contract     *IntegrationId    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IntegrationIdCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IntegrationIdCallerSession struct {
+	Contract *IntegrationIdCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// IntegrationIdTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IntegrationIdTransactorSession struct {
+	Contract     *IntegrationIdTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// IntegrationIdRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IntegrationIdRaw struct {
+	Contract *IntegrationId // Generic contract binding to access the raw methods on
+}
+
+// IntegrationIdCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IntegrationIdCallerRaw struct {
+	Contract *IntegrationIdCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IntegrationIdTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IntegrationIdTransactorRaw struct {
+	Contract *IntegrationIdTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIntegrationId creates a new instance of IntegrationId, bound to a specific deployed contract.
+func NewIntegrationId(address common.Address, backend bind.ContractBackend) (*IntegrationId, error) {
+	contract, err := bindIntegrationId(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationId{IntegrationIdCaller: IntegrationIdCaller{contract: contract}, IntegrationIdTransactor: IntegrationIdTransactor{contract: contract}, IntegrationIdFilterer: IntegrationIdFilterer{contract: contract}}, nil
+}
+
+// NewIntegrationIdCaller creates a new read-only instance of IntegrationId, bound to a specific deployed contract.
+func NewIntegrationIdCaller(address common.Address, caller bind.ContractCaller) (*IntegrationIdCaller, error) {
+	contract, err := bindIntegrationId(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdCaller{contract: contract}, nil
+}
+
+// NewIntegrationIdTransactor creates a new write-only instance of IntegrationId, bound to a specific deployed contract.
+func NewIntegrationIdTransactor(address common.Address, transactor bind.ContractTransactor) (*IntegrationIdTransactor, error) {
+	contract, err := bindIntegrationId(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdTransactor{contract: contract}, nil
+}
+
+// NewIntegrationIdFilterer creates a new log filterer instance of IntegrationId, bound to a specific deployed contract.
+func NewIntegrationIdFilterer(address common.Address, filterer bind.ContractFilterer) (*IntegrationIdFilterer, error) {
+	contract, err := bindIntegrationId(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdFilterer{contract: contract}, nil
+}
+
+// bindIntegrationId binds a generic wrapper to an already deployed contract.
+func bindIntegrationId(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IntegrationIdMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IntegrationId *IntegrationIdRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IntegrationId.Contract.IntegrationIdCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IntegrationId *IntegrationIdRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IntegrationId.Contract.IntegrationIdTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IntegrationId *IntegrationIdRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IntegrationId.Contract.IntegrationIdTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IntegrationId *IntegrationIdCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IntegrationId.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IntegrationId *IntegrationIdTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IntegrationId.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IntegrationId *IntegrationIdTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IntegrationId.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) ADMINROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.ADMINROLE(&_IntegrationId.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) ADMINROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.ADMINROLE(&_IntegrationId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) BURNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "BURNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) BURNERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.BURNERROLE(&_IntegrationId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) BURNERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.BURNERROLE(&_IntegrationId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.DEFAULTADMINROLE(&_IntegrationId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.DEFAULTADMINROLE(&_IntegrationId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) MINTERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.MINTERROLE(&_IntegrationId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) MINTERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.MINTERROLE(&_IntegrationId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) TRANSFERERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "TRANSFERER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) TRANSFERERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.TRANSFERERROLE(&_IntegrationId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) TRANSFERERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.TRANSFERERROLE(&_IntegrationId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) UPGRADERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.UPGRADERROLE(&_IntegrationId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _IntegrationId.Contract.UPGRADERROLE(&_IntegrationId.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_IntegrationId *IntegrationIdCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_IntegrationId *IntegrationIdSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.BalanceOf(&_IntegrationId.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_IntegrationId *IntegrationIdCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.BalanceOf(&_IntegrationId.CallOpts, owner)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_IntegrationId *IntegrationIdCaller) DimoRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "dimoRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_IntegrationId *IntegrationIdSession) DimoRegistry() (common.Address, error) {
+	return _IntegrationId.Contract.DimoRegistry(&_IntegrationId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_IntegrationId *IntegrationIdCallerSession) DimoRegistry() (common.Address, error) {
+	return _IntegrationId.Contract.DimoRegistry(&_IntegrationId.CallOpts)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) Exists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "exists", tokenId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) Exists(tokenId *big.Int) (bool, error) {
+	return _IntegrationId.Contract.Exists(&_IntegrationId.CallOpts, tokenId)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) Exists(tokenId *big.Int) (bool, error) {
+	return _IntegrationId.Contract.Exists(&_IntegrationId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IntegrationId.Contract.GetApproved(&_IntegrationId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _IntegrationId.Contract.GetApproved(&_IntegrationId.CallOpts, tokenId)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _IntegrationId.Contract.GetRoleAdmin(&_IntegrationId.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _IntegrationId.Contract.GetRoleAdmin(&_IntegrationId.CallOpts, role)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) HasPrivilege(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "hasPrivilege", tokenId, privId, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _IntegrationId.Contract.HasPrivilege(&_IntegrationId.CallOpts, tokenId, privId, user)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _IntegrationId.Contract.HasPrivilege(&_IntegrationId.CallOpts, tokenId, privId, user)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _IntegrationId.Contract.HasRole(&_IntegrationId.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _IntegrationId.Contract.HasRole(&_IntegrationId.CallOpts, role, account)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IntegrationId.Contract.IsApprovedForAll(&_IntegrationId.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _IntegrationId.Contract.IsApprovedForAll(&_IntegrationId.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IntegrationId *IntegrationIdCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IntegrationId *IntegrationIdSession) Name() (string, error) {
+	return _IntegrationId.Contract.Name(&_IntegrationId.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_IntegrationId *IntegrationIdCallerSession) Name() (string, error) {
+	return _IntegrationId.Contract.Name(&_IntegrationId.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IntegrationId.Contract.OwnerOf(&_IntegrationId.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_IntegrationId *IntegrationIdCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _IntegrationId.Contract.OwnerOf(&_IntegrationId.CallOpts, tokenId)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_IntegrationId *IntegrationIdCaller) PrivilegeEntry(opts *bind.CallOpts, arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "privilegeEntry", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_IntegrationId *IntegrationIdSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.PrivilegeEntry(&_IntegrationId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_IntegrationId *IntegrationIdCallerSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.PrivilegeEntry(&_IntegrationId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_IntegrationId *IntegrationIdCaller) PrivilegeExpiresAt(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "privilegeExpiresAt", tokenId, privId, user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_IntegrationId *IntegrationIdSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.PrivilegeExpiresAt(&_IntegrationId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_IntegrationId *IntegrationIdCallerSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _IntegrationId.Contract.PrivilegeExpiresAt(&_IntegrationId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_IntegrationId *IntegrationIdCaller) PrivilegeRecord(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "privilegeRecord", arg0)
+
+	outstruct := new(struct {
+		Enabled     bool
+		Description string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Description = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_IntegrationId *IntegrationIdSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _IntegrationId.Contract.PrivilegeRecord(&_IntegrationId.CallOpts, arg0)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_IntegrationId *IntegrationIdCallerSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _IntegrationId.Contract.PrivilegeRecord(&_IntegrationId.CallOpts, arg0)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_IntegrationId *IntegrationIdSession) ProxiableUUID() ([32]byte, error) {
+	return _IntegrationId.Contract.ProxiableUUID(&_IntegrationId.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_IntegrationId *IntegrationIdCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _IntegrationId.Contract.ProxiableUUID(&_IntegrationId.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IntegrationId.Contract.SupportsInterface(&_IntegrationId.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _IntegrationId.Contract.SupportsInterface(&_IntegrationId.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IntegrationId *IntegrationIdCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IntegrationId *IntegrationIdSession) Symbol() (string, error) {
+	return _IntegrationId.Contract.Symbol(&_IntegrationId.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_IntegrationId *IntegrationIdCallerSession) Symbol() (string, error) {
+	return _IntegrationId.Contract.Symbol(&_IntegrationId.CallOpts)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_IntegrationId *IntegrationIdCaller) TokenIdToVersion(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "tokenIdToVersion", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_IntegrationId *IntegrationIdSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _IntegrationId.Contract.TokenIdToVersion(&_IntegrationId.CallOpts, arg0)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_IntegrationId *IntegrationIdCallerSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _IntegrationId.Contract.TokenIdToVersion(&_IntegrationId.CallOpts, arg0)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IntegrationId *IntegrationIdCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IntegrationId *IntegrationIdSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _IntegrationId.Contract.TokenURI(&_IntegrationId.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_IntegrationId *IntegrationIdCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _IntegrationId.Contract.TokenURI(&_IntegrationId.CallOpts, tokenId)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_IntegrationId *IntegrationIdCaller) TrustedForwarders(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _IntegrationId.contract.Call(opts, &out, "trustedForwarders", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_IntegrationId *IntegrationIdSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _IntegrationId.Contract.TrustedForwarders(&_IntegrationId.CallOpts, arg0)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_IntegrationId *IntegrationIdCallerSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _IntegrationId.Contract.TrustedForwarders(&_IntegrationId.CallOpts, arg0)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Approve(&_IntegrationId.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Approve(&_IntegrationId.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Burn(&_IntegrationId.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Burn(&_IntegrationId.TransactOpts, tokenId)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_IntegrationId *IntegrationIdTransactor) CreatePrivilege(opts *bind.TransactOpts, enabled bool, description string) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "createPrivilege", enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_IntegrationId *IntegrationIdSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.CreatePrivilege(&_IntegrationId.TransactOpts, enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.CreatePrivilege(&_IntegrationId.TransactOpts, enabled, description)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdTransactor) DisablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "disablePrivilege", privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.DisablePrivilege(&_IntegrationId.TransactOpts, privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.DisablePrivilege(&_IntegrationId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdTransactor) EnablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "enablePrivilege", privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.EnablePrivilege(&_IntegrationId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.EnablePrivilege(&_IntegrationId.TransactOpts, privId)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.GrantRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.GrantRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_IntegrationId *IntegrationIdTransactor) Initialize(opts *bind.TransactOpts, name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "initialize", name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_IntegrationId *IntegrationIdSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Initialize(&_IntegrationId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.Initialize(&_IntegrationId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.RenounceRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.RenounceRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.RevokeRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.RevokeRole(&_IntegrationId.TransactOpts, role, account)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdTransactor) SafeMint(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "safeMint", to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeMint(&_IntegrationId.TransactOpts, to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdTransactorSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeMint(&_IntegrationId.TransactOpts, to)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdTransactor) SafeMint0(opts *bind.TransactOpts, to common.Address, uri string) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "safeMint0", to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeMint0(&_IntegrationId.TransactOpts, to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_IntegrationId *IntegrationIdTransactorSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeMint0(&_IntegrationId.TransactOpts, to, uri)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeTransferFrom(&_IntegrationId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeTransferFrom(&_IntegrationId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IntegrationId *IntegrationIdTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IntegrationId *IntegrationIdSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeTransferFrom0(&_IntegrationId.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SafeTransferFrom0(&_IntegrationId.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_IntegrationId *IntegrationIdSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetApprovalForAll(&_IntegrationId.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetApprovalForAll(&_IntegrationId.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI_ string) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setBaseURI", baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_IntegrationId *IntegrationIdSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetBaseURI(&_IntegrationId.TransactOpts, baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetBaseURI(&_IntegrationId.TransactOpts, baseURI_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetDimoRegistryAddress(opts *bind.TransactOpts, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setDimoRegistryAddress", dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_IntegrationId *IntegrationIdSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetDimoRegistryAddress(&_IntegrationId.TransactOpts, dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetDimoRegistryAddress(&_IntegrationId.TransactOpts, dimoRegistry_)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetPrivilege(opts *bind.TransactOpts, tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setPrivilege", tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_IntegrationId *IntegrationIdSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetPrivilege(&_IntegrationId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetPrivilege(&_IntegrationId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetPrivileges(opts *bind.TransactOpts, privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setPrivileges", privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_IntegrationId *IntegrationIdSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetPrivileges(&_IntegrationId.TransactOpts, privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetPrivileges(&_IntegrationId.TransactOpts, privData)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_IntegrationId *IntegrationIdTransactor) SetTrustedForwarder(opts *bind.TransactOpts, addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "setTrustedForwarder", addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_IntegrationId *IntegrationIdSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetTrustedForwarder(&_IntegrationId.TransactOpts, addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _IntegrationId.Contract.SetTrustedForwarder(&_IntegrationId.TransactOpts, addr, trusted)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.TransferFrom(&_IntegrationId.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _IntegrationId.Contract.TransferFrom(&_IntegrationId.TransactOpts, from, to, tokenId)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_IntegrationId *IntegrationIdTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_IntegrationId *IntegrationIdSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.UpgradeTo(&_IntegrationId.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_IntegrationId *IntegrationIdTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _IntegrationId.Contract.UpgradeTo(&_IntegrationId.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_IntegrationId *IntegrationIdTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_IntegrationId *IntegrationIdSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.Contract.UpgradeToAndCall(&_IntegrationId.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_IntegrationId *IntegrationIdTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _IntegrationId.Contract.UpgradeToAndCall(&_IntegrationId.TransactOpts, newImplementation, data)
+}
+
+// IntegrationIdAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the IntegrationId contract.
+type IntegrationIdAdminChangedIterator struct {
+	Event *IntegrationIdAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdAdminChanged represents a AdminChanged event raised by the IntegrationId contract.
+type IntegrationIdAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_IntegrationId *IntegrationIdFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*IntegrationIdAdminChangedIterator, error) {
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdAdminChangedIterator{contract: _IntegrationId.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_IntegrationId *IntegrationIdFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *IntegrationIdAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdAdminChanged)
+				if err := _IntegrationId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_IntegrationId *IntegrationIdFilterer) ParseAdminChanged(log types.Log) (*IntegrationIdAdminChanged, error) {
+	event := new(IntegrationIdAdminChanged)
+	if err := _IntegrationId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the IntegrationId contract.
+type IntegrationIdApprovalIterator struct {
+	Event *IntegrationIdApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdApproval represents a Approval event raised by the IntegrationId contract.
+type IntegrationIdApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*IntegrationIdApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdApprovalIterator{contract: _IntegrationId.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IntegrationIdApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdApproval)
+				if err := _IntegrationId.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) ParseApproval(log types.Log) (*IntegrationIdApproval, error) {
+	event := new(IntegrationIdApproval)
+	if err := _IntegrationId.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the IntegrationId contract.
+type IntegrationIdApprovalForAllIterator struct {
+	Event *IntegrationIdApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdApprovalForAll represents a ApprovalForAll event raised by the IntegrationId contract.
+type IntegrationIdApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IntegrationId *IntegrationIdFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*IntegrationIdApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdApprovalForAllIterator{contract: _IntegrationId.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IntegrationId *IntegrationIdFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *IntegrationIdApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdApprovalForAll)
+				if err := _IntegrationId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_IntegrationId *IntegrationIdFilterer) ParseApprovalForAll(log types.Log) (*IntegrationIdApprovalForAll, error) {
+	event := new(IntegrationIdApprovalForAll)
+	if err := _IntegrationId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the IntegrationId contract.
+type IntegrationIdBatchMetadataUpdateIterator struct {
+	Event *IntegrationIdBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the IntegrationId contract.
+type IntegrationIdBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_IntegrationId *IntegrationIdFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*IntegrationIdBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdBatchMetadataUpdateIterator{contract: _IntegrationId.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_IntegrationId *IntegrationIdFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *IntegrationIdBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdBatchMetadataUpdate)
+				if err := _IntegrationId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_IntegrationId *IntegrationIdFilterer) ParseBatchMetadataUpdate(log types.Log) (*IntegrationIdBatchMetadataUpdate, error) {
+	event := new(IntegrationIdBatchMetadataUpdate)
+	if err := _IntegrationId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the IntegrationId contract.
+type IntegrationIdBeaconUpgradedIterator struct {
+	Event *IntegrationIdBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdBeaconUpgraded represents a BeaconUpgraded event raised by the IntegrationId contract.
+type IntegrationIdBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_IntegrationId *IntegrationIdFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*IntegrationIdBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdBeaconUpgradedIterator{contract: _IntegrationId.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_IntegrationId *IntegrationIdFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *IntegrationIdBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdBeaconUpgraded)
+				if err := _IntegrationId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_IntegrationId *IntegrationIdFilterer) ParseBeaconUpgraded(log types.Log) (*IntegrationIdBeaconUpgraded, error) {
+	event := new(IntegrationIdBeaconUpgraded)
+	if err := _IntegrationId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the IntegrationId contract.
+type IntegrationIdInitializedIterator struct {
+	Event *IntegrationIdInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdInitialized represents a Initialized event raised by the IntegrationId contract.
+type IntegrationIdInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_IntegrationId *IntegrationIdFilterer) FilterInitialized(opts *bind.FilterOpts) (*IntegrationIdInitializedIterator, error) {
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdInitializedIterator{contract: _IntegrationId.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_IntegrationId *IntegrationIdFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *IntegrationIdInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdInitialized)
+				if err := _IntegrationId.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_IntegrationId *IntegrationIdFilterer) ParseInitialized(log types.Log) (*IntegrationIdInitialized, error) {
+	event := new(IntegrationIdInitialized)
+	if err := _IntegrationId.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the IntegrationId contract.
+type IntegrationIdMetadataUpdateIterator struct {
+	Event *IntegrationIdMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdMetadataUpdate represents a MetadataUpdate event raised by the IntegrationId contract.
+type IntegrationIdMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_IntegrationId *IntegrationIdFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*IntegrationIdMetadataUpdateIterator, error) {
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdMetadataUpdateIterator{contract: _IntegrationId.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_IntegrationId *IntegrationIdFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *IntegrationIdMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdMetadataUpdate)
+				if err := _IntegrationId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_IntegrationId *IntegrationIdFilterer) ParseMetadataUpdate(log types.Log) (*IntegrationIdMetadataUpdate, error) {
+	event := new(IntegrationIdMetadataUpdate)
+	if err := _IntegrationId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdPrivilegeCreatedIterator is returned from FilterPrivilegeCreated and is used to iterate over the raw logs and unpacked data for PrivilegeCreated events raised by the IntegrationId contract.
+type IntegrationIdPrivilegeCreatedIterator struct {
+	Event *IntegrationIdPrivilegeCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdPrivilegeCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdPrivilegeCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdPrivilegeCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdPrivilegeCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdPrivilegeCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdPrivilegeCreated represents a PrivilegeCreated event raised by the IntegrationId contract.
+type IntegrationIdPrivilegeCreated struct {
+	PrivilegeId *big.Int
+	Enabled     bool
+	Description string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeCreated is a free log retrieval operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_IntegrationId *IntegrationIdFilterer) FilterPrivilegeCreated(opts *bind.FilterOpts, privilegeId []*big.Int) (*IntegrationIdPrivilegeCreatedIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdPrivilegeCreatedIterator{contract: _IntegrationId.contract, event: "PrivilegeCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeCreated is a free log subscription operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_IntegrationId *IntegrationIdFilterer) WatchPrivilegeCreated(opts *bind.WatchOpts, sink chan<- *IntegrationIdPrivilegeCreated, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdPrivilegeCreated)
+				if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeCreated is a log parse operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_IntegrationId *IntegrationIdFilterer) ParsePrivilegeCreated(log types.Log) (*IntegrationIdPrivilegeCreated, error) {
+	event := new(IntegrationIdPrivilegeCreated)
+	if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdPrivilegeDisabledIterator is returned from FilterPrivilegeDisabled and is used to iterate over the raw logs and unpacked data for PrivilegeDisabled events raised by the IntegrationId contract.
+type IntegrationIdPrivilegeDisabledIterator struct {
+	Event *IntegrationIdPrivilegeDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdPrivilegeDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdPrivilegeDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdPrivilegeDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdPrivilegeDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdPrivilegeDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdPrivilegeDisabled represents a PrivilegeDisabled event raised by the IntegrationId contract.
+type IntegrationIdPrivilegeDisabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeDisabled is a free log retrieval operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) FilterPrivilegeDisabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*IntegrationIdPrivilegeDisabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdPrivilegeDisabledIterator{contract: _IntegrationId.contract, event: "PrivilegeDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeDisabled is a free log subscription operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) WatchPrivilegeDisabled(opts *bind.WatchOpts, sink chan<- *IntegrationIdPrivilegeDisabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdPrivilegeDisabled)
+				if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeDisabled is a log parse operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) ParsePrivilegeDisabled(log types.Log) (*IntegrationIdPrivilegeDisabled, error) {
+	event := new(IntegrationIdPrivilegeDisabled)
+	if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdPrivilegeEnabledIterator is returned from FilterPrivilegeEnabled and is used to iterate over the raw logs and unpacked data for PrivilegeEnabled events raised by the IntegrationId contract.
+type IntegrationIdPrivilegeEnabledIterator struct {
+	Event *IntegrationIdPrivilegeEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdPrivilegeEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdPrivilegeEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdPrivilegeEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdPrivilegeEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdPrivilegeEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdPrivilegeEnabled represents a PrivilegeEnabled event raised by the IntegrationId contract.
+type IntegrationIdPrivilegeEnabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeEnabled is a free log retrieval operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) FilterPrivilegeEnabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*IntegrationIdPrivilegeEnabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdPrivilegeEnabledIterator{contract: _IntegrationId.contract, event: "PrivilegeEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeEnabled is a free log subscription operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) WatchPrivilegeEnabled(opts *bind.WatchOpts, sink chan<- *IntegrationIdPrivilegeEnabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdPrivilegeEnabled)
+				if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeEnabled is a log parse operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_IntegrationId *IntegrationIdFilterer) ParsePrivilegeEnabled(log types.Log) (*IntegrationIdPrivilegeEnabled, error) {
+	event := new(IntegrationIdPrivilegeEnabled)
+	if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdPrivilegeSetIterator is returned from FilterPrivilegeSet and is used to iterate over the raw logs and unpacked data for PrivilegeSet events raised by the IntegrationId contract.
+type IntegrationIdPrivilegeSetIterator struct {
+	Event *IntegrationIdPrivilegeSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdPrivilegeSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdPrivilegeSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdPrivilegeSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdPrivilegeSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdPrivilegeSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdPrivilegeSet represents a PrivilegeSet event raised by the IntegrationId contract.
+type IntegrationIdPrivilegeSet struct {
+	TokenId *big.Int
+	Version *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeSet is a free log retrieval operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_IntegrationId *IntegrationIdFilterer) FilterPrivilegeSet(opts *bind.FilterOpts, tokenId []*big.Int, privId []*big.Int, user []common.Address) (*IntegrationIdPrivilegeSetIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdPrivilegeSetIterator{contract: _IntegrationId.contract, event: "PrivilegeSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeSet is a free log subscription operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_IntegrationId *IntegrationIdFilterer) WatchPrivilegeSet(opts *bind.WatchOpts, sink chan<- *IntegrationIdPrivilegeSet, tokenId []*big.Int, privId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdPrivilegeSet)
+				if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeSet is a log parse operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_IntegrationId *IntegrationIdFilterer) ParsePrivilegeSet(log types.Log) (*IntegrationIdPrivilegeSet, error) {
+	event := new(IntegrationIdPrivilegeSet)
+	if err := _IntegrationId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the IntegrationId contract.
+type IntegrationIdRoleAdminChangedIterator struct {
+	Event *IntegrationIdRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdRoleAdminChanged represents a RoleAdminChanged event raised by the IntegrationId contract.
+type IntegrationIdRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_IntegrationId *IntegrationIdFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*IntegrationIdRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdRoleAdminChangedIterator{contract: _IntegrationId.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_IntegrationId *IntegrationIdFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *IntegrationIdRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdRoleAdminChanged)
+				if err := _IntegrationId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_IntegrationId *IntegrationIdFilterer) ParseRoleAdminChanged(log types.Log) (*IntegrationIdRoleAdminChanged, error) {
+	event := new(IntegrationIdRoleAdminChanged)
+	if err := _IntegrationId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the IntegrationId contract.
+type IntegrationIdRoleGrantedIterator struct {
+	Event *IntegrationIdRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdRoleGranted represents a RoleGranted event raised by the IntegrationId contract.
+type IntegrationIdRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*IntegrationIdRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdRoleGrantedIterator{contract: _IntegrationId.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *IntegrationIdRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdRoleGranted)
+				if err := _IntegrationId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) ParseRoleGranted(log types.Log) (*IntegrationIdRoleGranted, error) {
+	event := new(IntegrationIdRoleGranted)
+	if err := _IntegrationId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the IntegrationId contract.
+type IntegrationIdRoleRevokedIterator struct {
+	Event *IntegrationIdRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdRoleRevoked represents a RoleRevoked event raised by the IntegrationId contract.
+type IntegrationIdRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*IntegrationIdRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdRoleRevokedIterator{contract: _IntegrationId.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *IntegrationIdRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdRoleRevoked)
+				if err := _IntegrationId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_IntegrationId *IntegrationIdFilterer) ParseRoleRevoked(log types.Log) (*IntegrationIdRoleRevoked, error) {
+	event := new(IntegrationIdRoleRevoked)
+	if err := _IntegrationId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IntegrationId contract.
+type IntegrationIdTransferIterator struct {
+	Event *IntegrationIdTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdTransfer represents a Transfer event raised by the IntegrationId contract.
+type IntegrationIdTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*IntegrationIdTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdTransferIterator{contract: _IntegrationId.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IntegrationIdTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdTransfer)
+				if err := _IntegrationId.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_IntegrationId *IntegrationIdFilterer) ParseTransfer(log types.Log) (*IntegrationIdTransfer, error) {
+	event := new(IntegrationIdTransfer)
+	if err := _IntegrationId.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IntegrationIdUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the IntegrationId contract.
+type IntegrationIdUpgradedIterator struct {
+	Event *IntegrationIdUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IntegrationIdUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IntegrationIdUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IntegrationIdUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IntegrationIdUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IntegrationIdUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IntegrationIdUpgraded represents a Upgraded event raised by the IntegrationId contract.
+type IntegrationIdUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_IntegrationId *IntegrationIdFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*IntegrationIdUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IntegrationIdUpgradedIterator{contract: _IntegrationId.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_IntegrationId *IntegrationIdFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *IntegrationIdUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _IntegrationId.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IntegrationIdUpgraded)
+				if err := _IntegrationId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_IntegrationId *IntegrationIdFilterer) ParseUpgraded(log types.Log) (*IntegrationIdUpgraded, error) {
+	event := new(IntegrationIdUpgraded)
+	if err := _IntegrationId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
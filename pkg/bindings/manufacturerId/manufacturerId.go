@@ -0,0 +1,3778 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package manufacturerId
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ManufacturerIdSetPrivilegeData is an auto generated low-level Go binding around an user-defined struct.
+type ManufacturerIdSetPrivilegeData struct {
+	TokenId *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+}
+
+// ManufacturerIdMetaData contains all meta data concerning the ManufacturerId contract.
+var ManufacturerIdMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"PrivilegeCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"PrivilegeSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFERER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_dimoRegistry\",\"outputs\":[{\"internalType\":\"contractIDimoRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"createPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"disablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"enablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"hasPrivilege\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseUri_\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"privilegeEntry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"privilegeExpiresAt\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"privilegeRecord\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI_\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setDimoRegistryAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"setPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"internalType\":\"structManufacturerId.SetPrivilegeData[]\",\"name\":\"privData\",\"type\":\"tuple[]\"}],\"name\":\"setPrivileges\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenIdToVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// ManufacturerIdABI is the input ABI used to generate the binding from.
+// Deprecated: Use ManufacturerIdMetaData.ABI instead.
+var ManufacturerIdABI = ManufacturerIdMetaData.ABI
+
+// ManufacturerId is an auto generated Go binding around an Ethereum contract.
+type ManufacturerId struct {
+	ManufacturerIdCaller     // Read-only binding to the contract
+	ManufacturerIdTransactor // Write-only binding to the contract
+	ManufacturerIdFilterer   // Log filterer for contract events
+}
+
+// ManufacturerIdCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ManufacturerIdCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManufacturerIdTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ManufacturerIdTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManufacturerIdFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ManufacturerIdFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ManufacturerIdSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ManufacturerIdSession struct {
+	Contract     *ManufacturerId   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ManufacturerIdCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ManufacturerIdCallerSession struct {
+	Contract *ManufacturerIdCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// ManufacturerIdTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ManufacturerIdTransactorSession struct {
+	Contract     *ManufacturerIdTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ManufacturerIdRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ManufacturerIdRaw struct {
+	Contract *ManufacturerId // Generic contract binding to access the raw methods on
+}
+
+// ManufacturerIdCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ManufacturerIdCallerRaw struct {
+	Contract *ManufacturerIdCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ManufacturerIdTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ManufacturerIdTransactorRaw struct {
+	Contract *ManufacturerIdTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewManufacturerId creates a new instance of ManufacturerId, bound to a specific deployed contract.
+func NewManufacturerId(address common.Address, backend bind.ContractBackend) (*ManufacturerId, error) {
+	contract, err := bindManufacturerId(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerId{ManufacturerIdCaller: ManufacturerIdCaller{contract: contract}, ManufacturerIdTransactor: ManufacturerIdTransactor{contract: contract}, ManufacturerIdFilterer: ManufacturerIdFilterer{contract: contract}}, nil
+}
+
+// NewManufacturerIdCaller creates a new read-only instance of ManufacturerId, bound to a specific deployed contract.
+func NewManufacturerIdCaller(address common.Address, caller bind.ContractCaller) (*ManufacturerIdCaller, error) {
+	contract, err := bindManufacturerId(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdCaller{contract: contract}, nil
+}
+
+// NewManufacturerIdTransactor creates a new write-only instance of ManufacturerId, bound to a specific deployed contract.
+func NewManufacturerIdTransactor(address common.Address, transactor bind.ContractTransactor) (*ManufacturerIdTransactor, error) {
+	contract, err := bindManufacturerId(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdTransactor{contract: contract}, nil
+}
+
+// NewManufacturerIdFilterer creates a new log filterer instance of ManufacturerId, bound to a specific deployed contract.
+func NewManufacturerIdFilterer(address common.Address, filterer bind.ContractFilterer) (*ManufacturerIdFilterer, error) {
+	contract, err := bindManufacturerId(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdFilterer{contract: contract}, nil
+}
+
+// bindManufacturerId binds a generic wrapper to an already deployed contract.
+func bindManufacturerId(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ManufacturerIdMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ManufacturerId *ManufacturerIdRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ManufacturerId.Contract.ManufacturerIdCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ManufacturerId *ManufacturerIdRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.ManufacturerIdTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ManufacturerId *ManufacturerIdRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.ManufacturerIdTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ManufacturerId *ManufacturerIdCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ManufacturerId.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ManufacturerId *ManufacturerIdTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ManufacturerId *ManufacturerIdTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) ADMINROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.ADMINROLE(&_ManufacturerId.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) ADMINROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.ADMINROLE(&_ManufacturerId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) BURNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "BURNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) BURNERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.BURNERROLE(&_ManufacturerId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) BURNERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.BURNERROLE(&_ManufacturerId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.DEFAULTADMINROLE(&_ManufacturerId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.DEFAULTADMINROLE(&_ManufacturerId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) MINTERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.MINTERROLE(&_ManufacturerId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) MINTERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.MINTERROLE(&_ManufacturerId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) TRANSFERERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "TRANSFERER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) TRANSFERERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.TRANSFERERROLE(&_ManufacturerId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) TRANSFERERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.TRANSFERERROLE(&_ManufacturerId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) UPGRADERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.UPGRADERROLE(&_ManufacturerId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _ManufacturerId.Contract.UPGRADERROLE(&_ManufacturerId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_ManufacturerId *ManufacturerIdCaller) DimoRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "_dimoRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_ManufacturerId *ManufacturerIdSession) DimoRegistry() (common.Address, error) {
+	return _ManufacturerId.Contract.DimoRegistry(&_ManufacturerId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_ManufacturerId *ManufacturerIdCallerSession) DimoRegistry() (common.Address, error) {
+	return _ManufacturerId.Contract.DimoRegistry(&_ManufacturerId.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.BalanceOf(&_ManufacturerId.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.BalanceOf(&_ManufacturerId.CallOpts, owner)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCaller) Exists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "exists", tokenId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdSession) Exists(tokenId *big.Int) (bool, error) {
+	return _ManufacturerId.Contract.Exists(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCallerSession) Exists(tokenId *big.Int) (bool, error) {
+	return _ManufacturerId.Contract.Exists(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ManufacturerId.Contract.GetApproved(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ManufacturerId.Contract.GetApproved(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ManufacturerId.Contract.GetRoleAdmin(&_ManufacturerId.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ManufacturerId.Contract.GetRoleAdmin(&_ManufacturerId.CallOpts, role)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCaller) HasPrivilege(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "hasPrivilege", tokenId, privId, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_ManufacturerId *ManufacturerIdSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _ManufacturerId.Contract.HasPrivilege(&_ManufacturerId.CallOpts, tokenId, privId, user)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCallerSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _ManufacturerId.Contract.HasPrivilege(&_ManufacturerId.CallOpts, tokenId, privId, user)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManufacturerId *ManufacturerIdSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ManufacturerId.Contract.HasRole(&_ManufacturerId.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ManufacturerId.Contract.HasRole(&_ManufacturerId.CallOpts, role, account)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ManufacturerId *ManufacturerIdSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ManufacturerId.Contract.IsApprovedForAll(&_ManufacturerId.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ManufacturerId.Contract.IsApprovedForAll(&_ManufacturerId.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManufacturerId *ManufacturerIdCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManufacturerId *ManufacturerIdSession) Name() (string, error) {
+	return _ManufacturerId.Contract.Name(&_ManufacturerId.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ManufacturerId *ManufacturerIdCallerSession) Name() (string, error) {
+	return _ManufacturerId.Contract.Name(&_ManufacturerId.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ManufacturerId.Contract.OwnerOf(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ManufacturerId *ManufacturerIdCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ManufacturerId.Contract.OwnerOf(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCaller) PrivilegeEntry(opts *bind.CallOpts, arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "privilegeEntry", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.PrivilegeEntry(&_ManufacturerId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCallerSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.PrivilegeEntry(&_ManufacturerId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCaller) PrivilegeExpiresAt(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "privilegeExpiresAt", tokenId, privId, user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.PrivilegeExpiresAt(&_ManufacturerId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCallerSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _ManufacturerId.Contract.PrivilegeExpiresAt(&_ManufacturerId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdCaller) PrivilegeRecord(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "privilegeRecord", arg0)
+
+	outstruct := new(struct {
+		Enabled     bool
+		Description string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Description = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _ManufacturerId.Contract.PrivilegeRecord(&_ManufacturerId.CallOpts, arg0)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdCallerSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _ManufacturerId.Contract.PrivilegeRecord(&_ManufacturerId.CallOpts, arg0)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdSession) ProxiableUUID() ([32]byte, error) {
+	return _ManufacturerId.Contract.ProxiableUUID(&_ManufacturerId.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ManufacturerId *ManufacturerIdCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _ManufacturerId.Contract.ProxiableUUID(&_ManufacturerId.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ManufacturerId.Contract.SupportsInterface(&_ManufacturerId.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ManufacturerId *ManufacturerIdCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ManufacturerId.Contract.SupportsInterface(&_ManufacturerId.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManufacturerId *ManufacturerIdCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManufacturerId *ManufacturerIdSession) Symbol() (string, error) {
+	return _ManufacturerId.Contract.Symbol(&_ManufacturerId.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ManufacturerId *ManufacturerIdCallerSession) Symbol() (string, error) {
+	return _ManufacturerId.Contract.Symbol(&_ManufacturerId.CallOpts)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCaller) TokenIdToVersion(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "tokenIdToVersion", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _ManufacturerId.Contract.TokenIdToVersion(&_ManufacturerId.CallOpts, arg0)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_ManufacturerId *ManufacturerIdCallerSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _ManufacturerId.Contract.TokenIdToVersion(&_ManufacturerId.CallOpts, arg0)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ManufacturerId *ManufacturerIdCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ManufacturerId.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ManufacturerId *ManufacturerIdSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ManufacturerId.Contract.TokenURI(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ManufacturerId *ManufacturerIdCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ManufacturerId.Contract.TokenURI(&_ManufacturerId.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Approve(&_ManufacturerId.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Approve(&_ManufacturerId.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Burn(&_ManufacturerId.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Burn(&_ManufacturerId.TransactOpts, tokenId)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) CreatePrivilege(opts *bind.TransactOpts, enabled bool, description string) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "createPrivilege", enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_ManufacturerId *ManufacturerIdSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.CreatePrivilege(&_ManufacturerId.TransactOpts, enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.CreatePrivilege(&_ManufacturerId.TransactOpts, enabled, description)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) DisablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "disablePrivilege", privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.DisablePrivilege(&_ManufacturerId.TransactOpts, privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.DisablePrivilege(&_ManufacturerId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) EnablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "enablePrivilege", privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.EnablePrivilege(&_ManufacturerId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.EnablePrivilege(&_ManufacturerId.TransactOpts, privId)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.GrantRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.GrantRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5c6d8da1.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) Initialize(opts *bind.TransactOpts, name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "initialize", name_, symbol_, baseUri_, dimoRegistry_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5c6d8da1.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_) returns()
+func (_ManufacturerId *ManufacturerIdSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Initialize(&_ManufacturerId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5c6d8da1.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.Initialize(&_ManufacturerId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.RenounceRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.RenounceRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.RevokeRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.RevokeRole(&_ManufacturerId.TransactOpts, role, account)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdTransactor) SafeMint(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "safeMint", to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeMint(&_ManufacturerId.TransactOpts, to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdTransactorSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeMint(&_ManufacturerId.TransactOpts, to)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdTransactor) SafeMint0(opts *bind.TransactOpts, to common.Address, uri string) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "safeMint0", to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeMint0(&_ManufacturerId.TransactOpts, to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_ManufacturerId *ManufacturerIdTransactorSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeMint0(&_ManufacturerId.TransactOpts, to, uri)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeTransferFrom(&_ManufacturerId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeTransferFrom(&_ManufacturerId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ManufacturerId *ManufacturerIdSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeTransferFrom0(&_ManufacturerId.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SafeTransferFrom0(&_ManufacturerId.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ManufacturerId *ManufacturerIdSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetApprovalForAll(&_ManufacturerId.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetApprovalForAll(&_ManufacturerId.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI_ string) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "setBaseURI", baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_ManufacturerId *ManufacturerIdSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetBaseURI(&_ManufacturerId.TransactOpts, baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetBaseURI(&_ManufacturerId.TransactOpts, baseURI_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SetDimoRegistryAddress(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "setDimoRegistryAddress", addr)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_ManufacturerId *ManufacturerIdSession) SetDimoRegistryAddress(addr common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetDimoRegistryAddress(&_ManufacturerId.TransactOpts, addr)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SetDimoRegistryAddress(addr common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetDimoRegistryAddress(&_ManufacturerId.TransactOpts, addr)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SetPrivilege(opts *bind.TransactOpts, tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "setPrivilege", tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_ManufacturerId *ManufacturerIdSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetPrivilege(&_ManufacturerId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetPrivilege(&_ManufacturerId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) SetPrivileges(opts *bind.TransactOpts, privData []ManufacturerIdSetPrivilegeData) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "setPrivileges", privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_ManufacturerId *ManufacturerIdSession) SetPrivileges(privData []ManufacturerIdSetPrivilegeData) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetPrivileges(&_ManufacturerId.TransactOpts, privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) SetPrivileges(privData []ManufacturerIdSetPrivilegeData) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.SetPrivileges(&_ManufacturerId.TransactOpts, privData)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.TransferFrom(&_ManufacturerId.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.TransferFrom(&_ManufacturerId.TransactOpts, from, to, tokenId)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_ManufacturerId *ManufacturerIdTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_ManufacturerId *ManufacturerIdSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.UpgradeTo(&_ManufacturerId.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.UpgradeTo(&_ManufacturerId.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ManufacturerId *ManufacturerIdTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ManufacturerId *ManufacturerIdSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.UpgradeToAndCall(&_ManufacturerId.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ManufacturerId *ManufacturerIdTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ManufacturerId.Contract.UpgradeToAndCall(&_ManufacturerId.TransactOpts, newImplementation, data)
+}
+
+// ManufacturerIdAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the ManufacturerId contract.
+type ManufacturerIdAdminChangedIterator struct {
+	Event *ManufacturerIdAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdAdminChanged represents a AdminChanged event raised by the ManufacturerId contract.
+type ManufacturerIdAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*ManufacturerIdAdminChangedIterator, error) {
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdAdminChangedIterator{contract: _ManufacturerId.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *ManufacturerIdAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdAdminChanged)
+				if err := _ManufacturerId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseAdminChanged(log types.Log) (*ManufacturerIdAdminChanged, error) {
+	event := new(ManufacturerIdAdminChanged)
+	if err := _ManufacturerId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ManufacturerId contract.
+type ManufacturerIdApprovalIterator struct {
+	Event *ManufacturerIdApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdApproval represents a Approval event raised by the ManufacturerId contract.
+type ManufacturerIdApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ManufacturerIdApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdApprovalIterator{contract: _ManufacturerId.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ManufacturerIdApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdApproval)
+				if err := _ManufacturerId.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseApproval(log types.Log) (*ManufacturerIdApproval, error) {
+	event := new(ManufacturerIdApproval)
+	if err := _ManufacturerId.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ManufacturerId contract.
+type ManufacturerIdApprovalForAllIterator struct {
+	Event *ManufacturerIdApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdApprovalForAll represents a ApprovalForAll event raised by the ManufacturerId contract.
+type ManufacturerIdApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ManufacturerIdApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdApprovalForAllIterator{contract: _ManufacturerId.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ManufacturerIdApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdApprovalForAll)
+				if err := _ManufacturerId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseApprovalForAll(log types.Log) (*ManufacturerIdApprovalForAll, error) {
+	event := new(ManufacturerIdApprovalForAll)
+	if err := _ManufacturerId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the ManufacturerId contract.
+type ManufacturerIdBatchMetadataUpdateIterator struct {
+	Event *ManufacturerIdBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the ManufacturerId contract.
+type ManufacturerIdBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*ManufacturerIdBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdBatchMetadataUpdateIterator{contract: _ManufacturerId.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *ManufacturerIdBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdBatchMetadataUpdate)
+				if err := _ManufacturerId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseBatchMetadataUpdate(log types.Log) (*ManufacturerIdBatchMetadataUpdate, error) {
+	event := new(ManufacturerIdBatchMetadataUpdate)
+	if err := _ManufacturerId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the ManufacturerId contract.
+type ManufacturerIdBeaconUpgradedIterator struct {
+	Event *ManufacturerIdBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdBeaconUpgraded represents a BeaconUpgraded event raised by the ManufacturerId contract.
+type ManufacturerIdBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*ManufacturerIdBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdBeaconUpgradedIterator{contract: _ManufacturerId.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *ManufacturerIdBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdBeaconUpgraded)
+				if err := _ManufacturerId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseBeaconUpgraded(log types.Log) (*ManufacturerIdBeaconUpgraded, error) {
+	event := new(ManufacturerIdBeaconUpgraded)
+	if err := _ManufacturerId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ManufacturerId contract.
+type ManufacturerIdInitializedIterator struct {
+	Event *ManufacturerIdInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdInitialized represents a Initialized event raised by the ManufacturerId contract.
+type ManufacturerIdInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterInitialized(opts *bind.FilterOpts) (*ManufacturerIdInitializedIterator, error) {
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdInitializedIterator{contract: _ManufacturerId.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ManufacturerIdInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdInitialized)
+				if err := _ManufacturerId.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseInitialized(log types.Log) (*ManufacturerIdInitialized, error) {
+	event := new(ManufacturerIdInitialized)
+	if err := _ManufacturerId.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the ManufacturerId contract.
+type ManufacturerIdMetadataUpdateIterator struct {
+	Event *ManufacturerIdMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdMetadataUpdate represents a MetadataUpdate event raised by the ManufacturerId contract.
+type ManufacturerIdMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*ManufacturerIdMetadataUpdateIterator, error) {
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdMetadataUpdateIterator{contract: _ManufacturerId.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *ManufacturerIdMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdMetadataUpdate)
+				if err := _ManufacturerId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseMetadataUpdate(log types.Log) (*ManufacturerIdMetadataUpdate, error) {
+	event := new(ManufacturerIdMetadataUpdate)
+	if err := _ManufacturerId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdPrivilegeCreatedIterator is returned from FilterPrivilegeCreated and is used to iterate over the raw logs and unpacked data for PrivilegeCreated events raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeCreatedIterator struct {
+	Event *ManufacturerIdPrivilegeCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdPrivilegeCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdPrivilegeCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdPrivilegeCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdPrivilegeCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdPrivilegeCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdPrivilegeCreated represents a PrivilegeCreated event raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeCreated struct {
+	PrivilegeId *big.Int
+	Enabled     bool
+	Description string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeCreated is a free log retrieval operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterPrivilegeCreated(opts *bind.FilterOpts, privilegeId []*big.Int) (*ManufacturerIdPrivilegeCreatedIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdPrivilegeCreatedIterator{contract: _ManufacturerId.contract, event: "PrivilegeCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeCreated is a free log subscription operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchPrivilegeCreated(opts *bind.WatchOpts, sink chan<- *ManufacturerIdPrivilegeCreated, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdPrivilegeCreated)
+				if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeCreated is a log parse operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_ManufacturerId *ManufacturerIdFilterer) ParsePrivilegeCreated(log types.Log) (*ManufacturerIdPrivilegeCreated, error) {
+	event := new(ManufacturerIdPrivilegeCreated)
+	if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdPrivilegeDisabledIterator is returned from FilterPrivilegeDisabled and is used to iterate over the raw logs and unpacked data for PrivilegeDisabled events raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeDisabledIterator struct {
+	Event *ManufacturerIdPrivilegeDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdPrivilegeDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdPrivilegeDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdPrivilegeDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdPrivilegeDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdPrivilegeDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdPrivilegeDisabled represents a PrivilegeDisabled event raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeDisabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeDisabled is a free log retrieval operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterPrivilegeDisabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*ManufacturerIdPrivilegeDisabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdPrivilegeDisabledIterator{contract: _ManufacturerId.contract, event: "PrivilegeDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeDisabled is a free log subscription operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchPrivilegeDisabled(opts *bind.WatchOpts, sink chan<- *ManufacturerIdPrivilegeDisabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdPrivilegeDisabled)
+				if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeDisabled is a log parse operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParsePrivilegeDisabled(log types.Log) (*ManufacturerIdPrivilegeDisabled, error) {
+	event := new(ManufacturerIdPrivilegeDisabled)
+	if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdPrivilegeEnabledIterator is returned from FilterPrivilegeEnabled and is used to iterate over the raw logs and unpacked data for PrivilegeEnabled events raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeEnabledIterator struct {
+	Event *ManufacturerIdPrivilegeEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdPrivilegeEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdPrivilegeEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdPrivilegeEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdPrivilegeEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdPrivilegeEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdPrivilegeEnabled represents a PrivilegeEnabled event raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeEnabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeEnabled is a free log retrieval operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterPrivilegeEnabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*ManufacturerIdPrivilegeEnabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdPrivilegeEnabledIterator{contract: _ManufacturerId.contract, event: "PrivilegeEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeEnabled is a free log subscription operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchPrivilegeEnabled(opts *bind.WatchOpts, sink chan<- *ManufacturerIdPrivilegeEnabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdPrivilegeEnabled)
+				if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeEnabled is a log parse operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParsePrivilegeEnabled(log types.Log) (*ManufacturerIdPrivilegeEnabled, error) {
+	event := new(ManufacturerIdPrivilegeEnabled)
+	if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdPrivilegeSetIterator is returned from FilterPrivilegeSet and is used to iterate over the raw logs and unpacked data for PrivilegeSet events raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeSetIterator struct {
+	Event *ManufacturerIdPrivilegeSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdPrivilegeSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdPrivilegeSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdPrivilegeSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdPrivilegeSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdPrivilegeSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdPrivilegeSet represents a PrivilegeSet event raised by the ManufacturerId contract.
+type ManufacturerIdPrivilegeSet struct {
+	TokenId *big.Int
+	Version *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeSet is a free log retrieval operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterPrivilegeSet(opts *bind.FilterOpts, tokenId []*big.Int, privId []*big.Int, user []common.Address) (*ManufacturerIdPrivilegeSetIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdPrivilegeSetIterator{contract: _ManufacturerId.contract, event: "PrivilegeSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeSet is a free log subscription operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchPrivilegeSet(opts *bind.WatchOpts, sink chan<- *ManufacturerIdPrivilegeSet, tokenId []*big.Int, privId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdPrivilegeSet)
+				if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeSet is a log parse operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_ManufacturerId *ManufacturerIdFilterer) ParsePrivilegeSet(log types.Log) (*ManufacturerIdPrivilegeSet, error) {
+	event := new(ManufacturerIdPrivilegeSet)
+	if err := _ManufacturerId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the ManufacturerId contract.
+type ManufacturerIdRoleAdminChangedIterator struct {
+	Event *ManufacturerIdRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdRoleAdminChanged represents a RoleAdminChanged event raised by the ManufacturerId contract.
+type ManufacturerIdRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*ManufacturerIdRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdRoleAdminChangedIterator{contract: _ManufacturerId.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *ManufacturerIdRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdRoleAdminChanged)
+				if err := _ManufacturerId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseRoleAdminChanged(log types.Log) (*ManufacturerIdRoleAdminChanged, error) {
+	event := new(ManufacturerIdRoleAdminChanged)
+	if err := _ManufacturerId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the ManufacturerId contract.
+type ManufacturerIdRoleGrantedIterator struct {
+	Event *ManufacturerIdRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdRoleGranted represents a RoleGranted event raised by the ManufacturerId contract.
+type ManufacturerIdRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ManufacturerIdRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdRoleGrantedIterator{contract: _ManufacturerId.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *ManufacturerIdRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdRoleGranted)
+				if err := _ManufacturerId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseRoleGranted(log types.Log) (*ManufacturerIdRoleGranted, error) {
+	event := new(ManufacturerIdRoleGranted)
+	if err := _ManufacturerId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the ManufacturerId contract.
+type ManufacturerIdRoleRevokedIterator struct {
+	Event *ManufacturerIdRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdRoleRevoked represents a RoleRevoked event raised by the ManufacturerId contract.
+type ManufacturerIdRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ManufacturerIdRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdRoleRevokedIterator{contract: _ManufacturerId.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *ManufacturerIdRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdRoleRevoked)
+				if err := _ManufacturerId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseRoleRevoked(log types.Log) (*ManufacturerIdRoleRevoked, error) {
+	event := new(ManufacturerIdRoleRevoked)
+	if err := _ManufacturerId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ManufacturerId contract.
+type ManufacturerIdTransferIterator struct {
+	Event *ManufacturerIdTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdTransfer represents a Transfer event raised by the ManufacturerId contract.
+type ManufacturerIdTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ManufacturerIdTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdTransferIterator{contract: _ManufacturerId.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ManufacturerIdTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdTransfer)
+				if err := _ManufacturerId.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseTransfer(log types.Log) (*ManufacturerIdTransfer, error) {
+	event := new(ManufacturerIdTransfer)
+	if err := _ManufacturerId.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManufacturerIdUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the ManufacturerId contract.
+type ManufacturerIdUpgradedIterator struct {
+	Event *ManufacturerIdUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManufacturerIdUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManufacturerIdUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManufacturerIdUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManufacturerIdUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManufacturerIdUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManufacturerIdUpgraded represents a Upgraded event raised by the ManufacturerId contract.
+type ManufacturerIdUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ManufacturerId *ManufacturerIdFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*ManufacturerIdUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManufacturerIdUpgradedIterator{contract: _ManufacturerId.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ManufacturerId *ManufacturerIdFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *ManufacturerIdUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ManufacturerId.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManufacturerIdUpgraded)
+				if err := _ManufacturerId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ManufacturerId *ManufacturerIdFilterer) ParseUpgraded(log types.Log) (*ManufacturerIdUpgraded, error) {
+	event := new(ManufacturerIdUpgraded)
+	if err := _ManufacturerId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3830 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package syntheticDeviceId
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MultiPrivilegeSetPrivilegeData is an auto generated low-level Go binding around an user-defined struct.
+type MultiPrivilegeSetPrivilegeData struct {
+	TokenId *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+}
+
+// SyntheticDeviceIdMetaData contains all meta data concerning the SyntheticDeviceId contract.
+var SyntheticDeviceIdMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"Unauthorized\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"PrivilegeCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"PrivilegeSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFERER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"createPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"dimoRegistry\",\"outputs\":[{\"internalType\":\"contractIDimoRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"disablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"enablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"hasPrivilege\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseUri_\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"trustedForwarders_\",\"type\":\"address[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"privilegeEntry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"privilegeExpiresAt\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"privilegeRecord\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI_\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"}],\"name\":\"setDimoRegistryAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"setPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"internalType\":\"structMultiPrivilege.SetPrivilegeData[]\",\"name\":\"privData\",\"type\":\"tuple[]\"}],\"name\":\"setPrivileges\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"trusted\",\"type\":\"bool\"}],\"name\":\"setTrustedForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenIdToVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"trustedForwarders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// SyntheticDeviceIdABI is the input ABI used to generate the binding from.
+// Deprecated: Use SyntheticDeviceIdMetaData.ABI instead.
+var SyntheticDeviceIdABI = SyntheticDeviceIdMetaData.ABI
+
+// SyntheticDeviceId is an auto generated Go binding around an Ethereum contract.
+type SyntheticDeviceId struct {
+	SyntheticDeviceIdCaller     // Read-only binding to the contract
+	SyntheticDeviceIdTransactor // Write-only binding to the contract
+	SyntheticDeviceIdFilterer   // Log filterer for contract events
+}
+
+// SyntheticDeviceIdCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SyntheticDeviceIdCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SyntheticDeviceIdTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SyntheticDeviceIdTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SyntheticDeviceIdFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SyntheticDeviceIdFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SyntheticDeviceIdSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SyntheticDeviceIdSession struct {
+	Contract     *SyntheticDeviceId // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// SyntheticDeviceIdCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SyntheticDeviceIdCallerSession struct {
+	Contract *SyntheticDeviceIdCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// SyntheticDeviceIdTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SyntheticDeviceIdTransactorSession struct {
+	Contract     *SyntheticDeviceIdTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// SyntheticDeviceIdRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SyntheticDeviceIdRaw struct {
+	Contract *SyntheticDeviceId // Generic contract binding to access the raw methods on
+}
+
+// SyntheticDeviceIdCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SyntheticDeviceIdCallerRaw struct {
+	Contract *SyntheticDeviceIdCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SyntheticDeviceIdTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SyntheticDeviceIdTransactorRaw struct {
+	Contract *SyntheticDeviceIdTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSyntheticDeviceId creates a new instance of SyntheticDeviceId, bound to a specific deployed contract.
+func NewSyntheticDeviceId(address common.Address, backend bind.ContractBackend) (*SyntheticDeviceId, error) {
+	contract, err := bindSyntheticDeviceId(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceId{SyntheticDeviceIdCaller: SyntheticDeviceIdCaller{contract: contract}, SyntheticDeviceIdTransactor: SyntheticDeviceIdTransactor{contract: contract}, SyntheticDeviceIdFilterer: SyntheticDeviceIdFilterer{contract: contract}}, nil
+}
+
+// NewSyntheticDeviceIdCaller creates a new read-only instance of SyntheticDeviceId, bound to a specific deployed contract.
+func NewSyntheticDeviceIdCaller(address common.Address, caller bind.ContractCaller) (*SyntheticDeviceIdCaller, error) {
+	contract, err := bindSyntheticDeviceId(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdCaller{contract: contract}, nil
+}
+
+// NewSyntheticDeviceIdTransactor creates a new write-only instance of SyntheticDeviceId, bound to a specific deployed contract.
+func NewSyntheticDeviceIdTransactor(address common.Address, transactor bind.ContractTransactor) (*SyntheticDeviceIdTransactor, error) {
+	contract, err := bindSyntheticDeviceId(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdTransactor{contract: contract}, nil
+}
+
+// NewSyntheticDeviceIdFilterer creates a new log filterer instance of SyntheticDeviceId, bound to a specific deployed contract.
+func NewSyntheticDeviceIdFilterer(address common.Address, filterer bind.ContractFilterer) (*SyntheticDeviceIdFilterer, error) {
+	contract, err := bindSyntheticDeviceId(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdFilterer{contract: contract}, nil
+}
+
+// bindSyntheticDeviceId binds a generic wrapper to an already deployed contract.
+func bindSyntheticDeviceId(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SyntheticDeviceIdMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SyntheticDeviceId *SyntheticDeviceIdRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SyntheticDeviceId.Contract.SyntheticDeviceIdCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SyntheticDeviceId *SyntheticDeviceIdRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SyntheticDeviceIdTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SyntheticDeviceId *SyntheticDeviceIdRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SyntheticDeviceIdTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SyntheticDeviceId.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) ADMINROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.ADMINROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) ADMINROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.ADMINROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) BURNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "BURNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) BURNERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.BURNERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) BURNERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.BURNERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.DEFAULTADMINROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.DEFAULTADMINROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) MINTERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.MINTERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) MINTERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.MINTERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) TRANSFERERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "TRANSFERER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) TRANSFERERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.TRANSFERERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) TRANSFERERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.TRANSFERERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) UPGRADERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.UPGRADERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.UPGRADERROLE(&_SyntheticDeviceId.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.BalanceOf(&_SyntheticDeviceId.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.BalanceOf(&_SyntheticDeviceId.CallOpts, owner)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) DimoRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "dimoRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) DimoRegistry() (common.Address, error) {
+	return _SyntheticDeviceId.Contract.DimoRegistry(&_SyntheticDeviceId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x20678275.
+//
+// Solidity: function dimoRegistry() view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) DimoRegistry() (common.Address, error) {
+	return _SyntheticDeviceId.Contract.DimoRegistry(&_SyntheticDeviceId.CallOpts)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) Exists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "exists", tokenId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Exists(tokenId *big.Int) (bool, error) {
+	return _SyntheticDeviceId.Contract.Exists(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) Exists(tokenId *big.Int) (bool, error) {
+	return _SyntheticDeviceId.Contract.Exists(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _SyntheticDeviceId.Contract.GetApproved(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _SyntheticDeviceId.Contract.GetApproved(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.GetRoleAdmin(&_SyntheticDeviceId.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.GetRoleAdmin(&_SyntheticDeviceId.CallOpts, role)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) HasPrivilege(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "hasPrivilege", tokenId, privId, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.HasPrivilege(&_SyntheticDeviceId.CallOpts, tokenId, privId, user)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.HasPrivilege(&_SyntheticDeviceId.CallOpts, tokenId, privId, user)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.HasRole(&_SyntheticDeviceId.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.HasRole(&_SyntheticDeviceId.CallOpts, role, account)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.IsApprovedForAll(&_SyntheticDeviceId.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.IsApprovedForAll(&_SyntheticDeviceId.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Name() (string, error) {
+	return _SyntheticDeviceId.Contract.Name(&_SyntheticDeviceId.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) Name() (string, error) {
+	return _SyntheticDeviceId.Contract.Name(&_SyntheticDeviceId.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _SyntheticDeviceId.Contract.OwnerOf(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _SyntheticDeviceId.Contract.OwnerOf(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) PrivilegeEntry(opts *bind.CallOpts, arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "privilegeEntry", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeEntry(&_SyntheticDeviceId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeEntry(&_SyntheticDeviceId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) PrivilegeExpiresAt(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "privilegeExpiresAt", tokenId, privId, user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeExpiresAt(&_SyntheticDeviceId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeExpiresAt(&_SyntheticDeviceId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) PrivilegeRecord(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "privilegeRecord", arg0)
+
+	outstruct := new(struct {
+		Enabled     bool
+		Description string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Description = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeRecord(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _SyntheticDeviceId.Contract.PrivilegeRecord(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) ProxiableUUID() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.ProxiableUUID(&_SyntheticDeviceId.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _SyntheticDeviceId.Contract.ProxiableUUID(&_SyntheticDeviceId.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _SyntheticDeviceId.Contract.SupportsInterface(&_SyntheticDeviceId.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _SyntheticDeviceId.Contract.SupportsInterface(&_SyntheticDeviceId.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Symbol() (string, error) {
+	return _SyntheticDeviceId.Contract.Symbol(&_SyntheticDeviceId.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) Symbol() (string, error) {
+	return _SyntheticDeviceId.Contract.Symbol(&_SyntheticDeviceId.CallOpts)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) TokenIdToVersion(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "tokenIdToVersion", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.TokenIdToVersion(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _SyntheticDeviceId.Contract.TokenIdToVersion(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _SyntheticDeviceId.Contract.TokenURI(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _SyntheticDeviceId.Contract.TokenURI(&_SyntheticDeviceId.CallOpts, tokenId)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCaller) TrustedForwarders(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _SyntheticDeviceId.contract.Call(opts, &out, "trustedForwarders", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.TrustedForwarders(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_SyntheticDeviceId *SyntheticDeviceIdCallerSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _SyntheticDeviceId.Contract.TrustedForwarders(&_SyntheticDeviceId.CallOpts, arg0)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Approve(&_SyntheticDeviceId.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Approve(&_SyntheticDeviceId.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Burn(&_SyntheticDeviceId.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Burn(&_SyntheticDeviceId.TransactOpts, tokenId)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) CreatePrivilege(opts *bind.TransactOpts, enabled bool, description string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "createPrivilege", enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.CreatePrivilege(&_SyntheticDeviceId.TransactOpts, enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.CreatePrivilege(&_SyntheticDeviceId.TransactOpts, enabled, description)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) DisablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "disablePrivilege", privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.DisablePrivilege(&_SyntheticDeviceId.TransactOpts, privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.DisablePrivilege(&_SyntheticDeviceId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) EnablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "enablePrivilege", privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.EnablePrivilege(&_SyntheticDeviceId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.EnablePrivilege(&_SyntheticDeviceId.TransactOpts, privId)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.GrantRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.GrantRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) Initialize(opts *bind.TransactOpts, name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "initialize", name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Initialize(&_SyntheticDeviceId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x2cd0213f.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address[] trustedForwarders_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.Initialize(&_SyntheticDeviceId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, trustedForwarders_)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.RenounceRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.RenounceRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.RevokeRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.RevokeRole(&_SyntheticDeviceId.TransactOpts, role, account)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SafeMint(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "safeMint", to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeMint(&_SyntheticDeviceId.TransactOpts, to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeMint(&_SyntheticDeviceId.TransactOpts, to)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SafeMint0(opts *bind.TransactOpts, to common.Address, uri string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "safeMint0", to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeMint0(&_SyntheticDeviceId.TransactOpts, to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeMint0(&_SyntheticDeviceId.TransactOpts, to, uri)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeTransferFrom(&_SyntheticDeviceId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeTransferFrom(&_SyntheticDeviceId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeTransferFrom0(&_SyntheticDeviceId.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SafeTransferFrom0(&_SyntheticDeviceId.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetApprovalForAll(&_SyntheticDeviceId.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetApprovalForAll(&_SyntheticDeviceId.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI_ string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setBaseURI", baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetBaseURI(&_SyntheticDeviceId.TransactOpts, baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetBaseURI(&_SyntheticDeviceId.TransactOpts, baseURI_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetDimoRegistryAddress(opts *bind.TransactOpts, dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setDimoRegistryAddress", dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetDimoRegistryAddress(&_SyntheticDeviceId.TransactOpts, dimoRegistry_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address dimoRegistry_) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetDimoRegistryAddress(dimoRegistry_ common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetDimoRegistryAddress(&_SyntheticDeviceId.TransactOpts, dimoRegistry_)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetPrivilege(opts *bind.TransactOpts, tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setPrivilege", tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetPrivilege(&_SyntheticDeviceId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetPrivilege(&_SyntheticDeviceId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetPrivileges(opts *bind.TransactOpts, privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setPrivileges", privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetPrivileges(&_SyntheticDeviceId.TransactOpts, privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetPrivileges(&_SyntheticDeviceId.TransactOpts, privData)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) SetTrustedForwarder(opts *bind.TransactOpts, addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "setTrustedForwarder", addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetTrustedForwarder(&_SyntheticDeviceId.TransactOpts, addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.SetTrustedForwarder(&_SyntheticDeviceId.TransactOpts, addr, trusted)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.TransferFrom(&_SyntheticDeviceId.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.TransferFrom(&_SyntheticDeviceId.TransactOpts, from, to, tokenId)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.UpgradeTo(&_SyntheticDeviceId.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.UpgradeTo(&_SyntheticDeviceId.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.UpgradeToAndCall(&_SyntheticDeviceId.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_SyntheticDeviceId *SyntheticDeviceIdTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _SyntheticDeviceId.Contract.UpgradeToAndCall(&_SyntheticDeviceId.TransactOpts, newImplementation, data)
+}
+
+// SyntheticDeviceIdAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdAdminChangedIterator struct {
+	Event *SyntheticDeviceIdAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdAdminChanged represents a AdminChanged event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*SyntheticDeviceIdAdminChangedIterator, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdAdminChangedIterator{contract: _SyntheticDeviceId.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdAdminChanged)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseAdminChanged(log types.Log) (*SyntheticDeviceIdAdminChanged, error) {
+	event := new(SyntheticDeviceIdAdminChanged)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdApprovalIterator struct {
+	Event *SyntheticDeviceIdApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdApproval represents a Approval event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*SyntheticDeviceIdApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdApprovalIterator{contract: _SyntheticDeviceId.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdApproval)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseApproval(log types.Log) (*SyntheticDeviceIdApproval, error) {
+	event := new(SyntheticDeviceIdApproval)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdApprovalForAllIterator struct {
+	Event *SyntheticDeviceIdApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdApprovalForAll represents a ApprovalForAll event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*SyntheticDeviceIdApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdApprovalForAllIterator{contract: _SyntheticDeviceId.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdApprovalForAll)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseApprovalForAll(log types.Log) (*SyntheticDeviceIdApprovalForAll, error) {
+	event := new(SyntheticDeviceIdApprovalForAll)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdBatchMetadataUpdateIterator struct {
+	Event *SyntheticDeviceIdBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*SyntheticDeviceIdBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdBatchMetadataUpdateIterator{contract: _SyntheticDeviceId.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdBatchMetadataUpdate)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseBatchMetadataUpdate(log types.Log) (*SyntheticDeviceIdBatchMetadataUpdate, error) {
+	event := new(SyntheticDeviceIdBatchMetadataUpdate)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdBeaconUpgradedIterator struct {
+	Event *SyntheticDeviceIdBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdBeaconUpgraded represents a BeaconUpgraded event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*SyntheticDeviceIdBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdBeaconUpgradedIterator{contract: _SyntheticDeviceId.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdBeaconUpgraded)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseBeaconUpgraded(log types.Log) (*SyntheticDeviceIdBeaconUpgraded, error) {
+	event := new(SyntheticDeviceIdBeaconUpgraded)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdInitializedIterator struct {
+	Event *SyntheticDeviceIdInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdInitialized represents a Initialized event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterInitialized(opts *bind.FilterOpts) (*SyntheticDeviceIdInitializedIterator, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdInitializedIterator{contract: _SyntheticDeviceId.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdInitialized)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseInitialized(log types.Log) (*SyntheticDeviceIdInitialized, error) {
+	event := new(SyntheticDeviceIdInitialized)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdMetadataUpdateIterator struct {
+	Event *SyntheticDeviceIdMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdMetadataUpdate represents a MetadataUpdate event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*SyntheticDeviceIdMetadataUpdateIterator, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdMetadataUpdateIterator{contract: _SyntheticDeviceId.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdMetadataUpdate)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseMetadataUpdate(log types.Log) (*SyntheticDeviceIdMetadataUpdate, error) {
+	event := new(SyntheticDeviceIdMetadataUpdate)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdPrivilegeCreatedIterator is returned from FilterPrivilegeCreated and is used to iterate over the raw logs and unpacked data for PrivilegeCreated events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeCreatedIterator struct {
+	Event *SyntheticDeviceIdPrivilegeCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdPrivilegeCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdPrivilegeCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdPrivilegeCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdPrivilegeCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdPrivilegeCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdPrivilegeCreated represents a PrivilegeCreated event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeCreated struct {
+	PrivilegeId *big.Int
+	Enabled     bool
+	Description string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeCreated is a free log retrieval operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterPrivilegeCreated(opts *bind.FilterOpts, privilegeId []*big.Int) (*SyntheticDeviceIdPrivilegeCreatedIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdPrivilegeCreatedIterator{contract: _SyntheticDeviceId.contract, event: "PrivilegeCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeCreated is a free log subscription operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchPrivilegeCreated(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdPrivilegeCreated, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdPrivilegeCreated)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeCreated is a log parse operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParsePrivilegeCreated(log types.Log) (*SyntheticDeviceIdPrivilegeCreated, error) {
+	event := new(SyntheticDeviceIdPrivilegeCreated)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdPrivilegeDisabledIterator is returned from FilterPrivilegeDisabled and is used to iterate over the raw logs and unpacked data for PrivilegeDisabled events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeDisabledIterator struct {
+	Event *SyntheticDeviceIdPrivilegeDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdPrivilegeDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdPrivilegeDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdPrivilegeDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdPrivilegeDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdPrivilegeDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdPrivilegeDisabled represents a PrivilegeDisabled event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeDisabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeDisabled is a free log retrieval operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterPrivilegeDisabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*SyntheticDeviceIdPrivilegeDisabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdPrivilegeDisabledIterator{contract: _SyntheticDeviceId.contract, event: "PrivilegeDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeDisabled is a free log subscription operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchPrivilegeDisabled(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdPrivilegeDisabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdPrivilegeDisabled)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeDisabled is a log parse operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParsePrivilegeDisabled(log types.Log) (*SyntheticDeviceIdPrivilegeDisabled, error) {
+	event := new(SyntheticDeviceIdPrivilegeDisabled)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdPrivilegeEnabledIterator is returned from FilterPrivilegeEnabled and is used to iterate over the raw logs and unpacked data for PrivilegeEnabled events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeEnabledIterator struct {
+	Event *SyntheticDeviceIdPrivilegeEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdPrivilegeEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdPrivilegeEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdPrivilegeEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdPrivilegeEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdPrivilegeEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdPrivilegeEnabled represents a PrivilegeEnabled event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeEnabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeEnabled is a free log retrieval operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterPrivilegeEnabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*SyntheticDeviceIdPrivilegeEnabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdPrivilegeEnabledIterator{contract: _SyntheticDeviceId.contract, event: "PrivilegeEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeEnabled is a free log subscription operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchPrivilegeEnabled(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdPrivilegeEnabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdPrivilegeEnabled)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeEnabled is a log parse operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParsePrivilegeEnabled(log types.Log) (*SyntheticDeviceIdPrivilegeEnabled, error) {
+	event := new(SyntheticDeviceIdPrivilegeEnabled)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdPrivilegeSetIterator is returned from FilterPrivilegeSet and is used to iterate over the raw logs and unpacked data for PrivilegeSet events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeSetIterator struct {
+	Event *SyntheticDeviceIdPrivilegeSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdPrivilegeSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdPrivilegeSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdPrivilegeSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdPrivilegeSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdPrivilegeSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdPrivilegeSet represents a PrivilegeSet event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdPrivilegeSet struct {
+	TokenId *big.Int
+	Version *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeSet is a free log retrieval operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterPrivilegeSet(opts *bind.FilterOpts, tokenId []*big.Int, privId []*big.Int, user []common.Address) (*SyntheticDeviceIdPrivilegeSetIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdPrivilegeSetIterator{contract: _SyntheticDeviceId.contract, event: "PrivilegeSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeSet is a free log subscription operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchPrivilegeSet(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdPrivilegeSet, tokenId []*big.Int, privId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdPrivilegeSet)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeSet is a log parse operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParsePrivilegeSet(log types.Log) (*SyntheticDeviceIdPrivilegeSet, error) {
+	event := new(SyntheticDeviceIdPrivilegeSet)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleAdminChangedIterator struct {
+	Event *SyntheticDeviceIdRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdRoleAdminChanged represents a RoleAdminChanged event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*SyntheticDeviceIdRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdRoleAdminChangedIterator{contract: _SyntheticDeviceId.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdRoleAdminChanged)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseRoleAdminChanged(log types.Log) (*SyntheticDeviceIdRoleAdminChanged, error) {
+	event := new(SyntheticDeviceIdRoleAdminChanged)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleGrantedIterator struct {
+	Event *SyntheticDeviceIdRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdRoleGranted represents a RoleGranted event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*SyntheticDeviceIdRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdRoleGrantedIterator{contract: _SyntheticDeviceId.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdRoleGranted)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseRoleGranted(log types.Log) (*SyntheticDeviceIdRoleGranted, error) {
+	event := new(SyntheticDeviceIdRoleGranted)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleRevokedIterator struct {
+	Event *SyntheticDeviceIdRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdRoleRevoked represents a RoleRevoked event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*SyntheticDeviceIdRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdRoleRevokedIterator{contract: _SyntheticDeviceId.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdRoleRevoked)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseRoleRevoked(log types.Log) (*SyntheticDeviceIdRoleRevoked, error) {
+	event := new(SyntheticDeviceIdRoleRevoked)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdTransferIterator struct {
+	Event *SyntheticDeviceIdTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdTransfer represents a Transfer event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*SyntheticDeviceIdTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdTransferIterator{contract: _SyntheticDeviceId.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdTransfer)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseTransfer(log types.Log) (*SyntheticDeviceIdTransfer, error) {
+	event := new(SyntheticDeviceIdTransfer)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SyntheticDeviceIdUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdUpgradedIterator struct {
+	Event *SyntheticDeviceIdUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SyntheticDeviceIdUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SyntheticDeviceIdUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SyntheticDeviceIdUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SyntheticDeviceIdUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SyntheticDeviceIdUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SyntheticDeviceIdUpgraded represents a Upgraded event raised by the SyntheticDeviceId contract.
+type SyntheticDeviceIdUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*SyntheticDeviceIdUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SyntheticDeviceIdUpgradedIterator{contract: _SyntheticDeviceId.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *SyntheticDeviceIdUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _SyntheticDeviceId.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SyntheticDeviceIdUpgraded)
+				if err := _SyntheticDeviceId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_SyntheticDeviceId *SyntheticDeviceIdFilterer) ParseUpgraded(log types.Log) (*SyntheticDeviceIdUpgraded, error) {
+	event := new(SyntheticDeviceIdUpgraded)
+	if err := _SyntheticDeviceId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
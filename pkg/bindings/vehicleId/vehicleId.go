@@ -0,0 +1,4025 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package vehicleId
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MultiPrivilegeSetPrivilegeData is an auto generated low-level Go binding around an user-defined struct.
+type MultiPrivilegeSetPrivilegeData struct {
+	TokenId *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+}
+
+// VehicleIdSacdInput is an auto generated low-level Go binding around an user-defined struct.
+type VehicleIdSacdInput struct {
+	Grantee     common.Address
+	Permissions *big.Int
+	Expiration  *big.Int
+	Source      string
+}
+
+// VehicleIdMetaData contains all meta data concerning the VehicleId contract.
+var VehicleIdMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"idProxy\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"errorMessage\",\"type\":\"string\"}],\"name\":\"TransferFailed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"Unauthorized\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"version\",\"type\":\"uint8\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"PrivilegeCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privilegeId\",\"type\":\"uint256\"}],\"name\":\"PrivilegeEnabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"PrivilegeSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BURNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SAFE_TRANSFER_FROM\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFERER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"_dimoRegistry\",\"outputs\":[{\"internalType\":\"contractIDimoRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"name\":\"createPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"disablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"}],\"name\":\"enablePrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getDefinitionURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"definitionURI\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"hasPrivilege\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseUri_\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"dimoRegistry_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"syntheticDeviceId_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"sacd_\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"trustedForwarders_\",\"type\":\"address[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"privilegeEntry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"}],\"name\":\"privilegeExpiresAt\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"privilegeRecord\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sacd\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"}],\"name\":\"safeMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI_\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setDimoRegistryAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"name\":\"setPrivilege\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"privId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"expires\",\"type\":\"uint256\"}],\"internalType\":\"structMultiPrivilege.SetPrivilegeData[]\",\"name\":\"privData\",\"type\":\"tuple[]\"}],\"name\":\"setPrivileges\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"permissions\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"source\",\"type\":\"string\"}],\"internalType\":\"structVehicleId.SacdInput\",\"name\":\"sacdInput\",\"type\":\"tuple\"}],\"name\":\"setSacd\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setSacdAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setSyntheticDeviceIdAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"trusted\",\"type\":\"bool\"}],\"name\":\"setTrustedForwarder\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"syntheticDeviceId\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokenIdToVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"trustedForwarders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// VehicleIdABI is the input ABI used to generate the binding from.
+// Deprecated: Use VehicleIdMetaData.ABI instead.
+var VehicleIdABI = VehicleIdMetaData.ABI
+
+// VehicleId is an auto generated Go binding around an Ethereum contract.
+type VehicleId struct {
+	VehicleIdCaller     // Read-only binding to the contract
+	VehicleIdTransactor // Write-only binding to the contract
+	VehicleIdFilterer   // Log filterer for contract events
+}
+
+// VehicleIdCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VehicleIdCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VehicleIdTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VehicleIdTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VehicleIdFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VehicleIdFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VehicleIdSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VehicleIdSession struct {
+	Contract     *VehicleId        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VehicleIdCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VehicleIdCallerSession struct {
+	Contract *VehicleIdCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// VehicleIdTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VehicleIdTransactorSession struct {
+	Contract     *VehicleIdTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// VehicleIdRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VehicleIdRaw struct {
+	Contract *VehicleId // Generic contract binding to access the raw methods on
+}
+
+// VehicleIdCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VehicleIdCallerRaw struct {
+	Contract *VehicleIdCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VehicleIdTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VehicleIdTransactorRaw struct {
+	Contract *VehicleIdTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVehicleId creates a new instance of VehicleId, bound to a specific deployed contract.
+func NewVehicleId(address common.Address, backend bind.ContractBackend) (*VehicleId, error) {
+	contract, err := bindVehicleId(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleId{VehicleIdCaller: VehicleIdCaller{contract: contract}, VehicleIdTransactor: VehicleIdTransactor{contract: contract}, VehicleIdFilterer: VehicleIdFilterer{contract: contract}}, nil
+}
+
+// NewVehicleIdCaller creates a new read-only instance of VehicleId, bound to a specific deployed contract.
+func NewVehicleIdCaller(address common.Address, caller bind.ContractCaller) (*VehicleIdCaller, error) {
+	contract, err := bindVehicleId(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdCaller{contract: contract}, nil
+}
+
+// NewVehicleIdTransactor creates a new write-only instance of VehicleId, bound to a specific deployed contract.
+func NewVehicleIdTransactor(address common.Address, transactor bind.ContractTransactor) (*VehicleIdTransactor, error) {
+	contract, err := bindVehicleId(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdTransactor{contract: contract}, nil
+}
+
+// NewVehicleIdFilterer creates a new log filterer instance of VehicleId, bound to a specific deployed contract.
+func NewVehicleIdFilterer(address common.Address, filterer bind.ContractFilterer) (*VehicleIdFilterer, error) {
+	contract, err := bindVehicleId(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdFilterer{contract: contract}, nil
+}
+
+// bindVehicleId binds a generic wrapper to an already deployed contract.
+func bindVehicleId(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VehicleIdMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VehicleId *VehicleIdRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VehicleId.Contract.VehicleIdCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VehicleId *VehicleIdRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VehicleId.Contract.VehicleIdTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VehicleId *VehicleIdRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VehicleId.Contract.VehicleIdTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VehicleId *VehicleIdCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VehicleId.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VehicleId *VehicleIdTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VehicleId.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VehicleId *VehicleIdTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VehicleId.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) ADMINROLE() ([32]byte, error) {
+	return _VehicleId.Contract.ADMINROLE(&_VehicleId.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) ADMINROLE() ([32]byte, error) {
+	return _VehicleId.Contract.ADMINROLE(&_VehicleId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) BURNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "BURNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) BURNERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.BURNERROLE(&_VehicleId.CallOpts)
+}
+
+// BURNERROLE is a free data retrieval call binding the contract method 0x282c51f3.
+//
+// Solidity: function BURNER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) BURNERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.BURNERROLE(&_VehicleId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VehicleId.Contract.DEFAULTADMINROLE(&_VehicleId.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _VehicleId.Contract.DEFAULTADMINROLE(&_VehicleId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) MINTERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.MINTERROLE(&_VehicleId.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) MINTERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.MINTERROLE(&_VehicleId.CallOpts)
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_VehicleId *VehicleIdCaller) SAFETRANSFERFROM(opts *bind.CallOpts) ([4]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "SAFE_TRANSFER_FROM")
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_VehicleId *VehicleIdSession) SAFETRANSFERFROM() ([4]byte, error) {
+	return _VehicleId.Contract.SAFETRANSFERFROM(&_VehicleId.CallOpts)
+}
+
+// SAFETRANSFERFROM is a free data retrieval call binding the contract method 0xe4cdbfdb.
+//
+// Solidity: function SAFE_TRANSFER_FROM() view returns(bytes4)
+func (_VehicleId *VehicleIdCallerSession) SAFETRANSFERFROM() ([4]byte, error) {
+	return _VehicleId.Contract.SAFETRANSFERFROM(&_VehicleId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) TRANSFERERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "TRANSFERER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) TRANSFERERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.TRANSFERERROLE(&_VehicleId.CallOpts)
+}
+
+// TRANSFERERROLE is a free data retrieval call binding the contract method 0x0ade7dc1.
+//
+// Solidity: function TRANSFERER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) TRANSFERERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.TRANSFERERROLE(&_VehicleId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) UPGRADERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "UPGRADER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) UPGRADERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.UPGRADERROLE(&_VehicleId.CallOpts)
+}
+
+// UPGRADERROLE is a free data retrieval call binding the contract method 0xf72c0d8b.
+//
+// Solidity: function UPGRADER_ROLE() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) UPGRADERROLE() ([32]byte, error) {
+	return _VehicleId.Contract.UPGRADERROLE(&_VehicleId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_VehicleId *VehicleIdCaller) DimoRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "_dimoRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_VehicleId *VehicleIdSession) DimoRegistry() (common.Address, error) {
+	return _VehicleId.Contract.DimoRegistry(&_VehicleId.CallOpts)
+}
+
+// DimoRegistry is a free data retrieval call binding the contract method 0x7625c605.
+//
+// Solidity: function _dimoRegistry() view returns(address)
+func (_VehicleId *VehicleIdCallerSession) DimoRegistry() (common.Address, error) {
+	return _VehicleId.Contract.DimoRegistry(&_VehicleId.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_VehicleId *VehicleIdCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_VehicleId *VehicleIdSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.BalanceOf(&_VehicleId.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_VehicleId *VehicleIdCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.BalanceOf(&_VehicleId.CallOpts, owner)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_VehicleId *VehicleIdCaller) Exists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "exists", tokenId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_VehicleId *VehicleIdSession) Exists(tokenId *big.Int) (bool, error) {
+	return _VehicleId.Contract.Exists(&_VehicleId.CallOpts, tokenId)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 tokenId) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) Exists(tokenId *big.Int) (bool, error) {
+	return _VehicleId.Contract.Exists(&_VehicleId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _VehicleId.Contract.GetApproved(&_VehicleId.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _VehicleId.Contract.GetApproved(&_VehicleId.CallOpts, tokenId)
+}
+
+// GetDefinitionURI is a free data retrieval call binding the contract method 0x9b12f1c9.
+//
+// Solidity: function getDefinitionURI(uint256 tokenId) view returns(string definitionURI)
+func (_VehicleId *VehicleIdCaller) GetDefinitionURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "getDefinitionURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetDefinitionURI is a free data retrieval call binding the contract method 0x9b12f1c9.
+//
+// Solidity: function getDefinitionURI(uint256 tokenId) view returns(string definitionURI)
+func (_VehicleId *VehicleIdSession) GetDefinitionURI(tokenId *big.Int) (string, error) {
+	return _VehicleId.Contract.GetDefinitionURI(&_VehicleId.CallOpts, tokenId)
+}
+
+// GetDefinitionURI is a free data retrieval call binding the contract method 0x9b12f1c9.
+//
+// Solidity: function getDefinitionURI(uint256 tokenId) view returns(string definitionURI)
+func (_VehicleId *VehicleIdCallerSession) GetDefinitionURI(tokenId *big.Int) (string, error) {
+	return _VehicleId.Contract.GetDefinitionURI(&_VehicleId.CallOpts, tokenId)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VehicleId *VehicleIdSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VehicleId.Contract.GetRoleAdmin(&_VehicleId.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _VehicleId.Contract.GetRoleAdmin(&_VehicleId.CallOpts, role)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_VehicleId *VehicleIdCaller) HasPrivilege(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "hasPrivilege", tokenId, privId, user)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_VehicleId *VehicleIdSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _VehicleId.Contract.HasPrivilege(&_VehicleId.CallOpts, tokenId, privId, user)
+}
+
+// HasPrivilege is a free data retrieval call binding the contract method 0x05d80b00.
+//
+// Solidity: function hasPrivilege(uint256 tokenId, uint256 privId, address user) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) HasPrivilege(tokenId *big.Int, privId *big.Int, user common.Address) (bool, error) {
+	return _VehicleId.Contract.HasPrivilege(&_VehicleId.CallOpts, tokenId, privId, user)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VehicleId *VehicleIdCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VehicleId *VehicleIdSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VehicleId.Contract.HasRole(&_VehicleId.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _VehicleId.Contract.HasRole(&_VehicleId.CallOpts, role, account)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_VehicleId *VehicleIdCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_VehicleId *VehicleIdSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _VehicleId.Contract.IsApprovedForAll(&_VehicleId.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _VehicleId.Contract.IsApprovedForAll(&_VehicleId.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VehicleId *VehicleIdCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VehicleId *VehicleIdSession) Name() (string, error) {
+	return _VehicleId.Contract.Name(&_VehicleId.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_VehicleId *VehicleIdCallerSession) Name() (string, error) {
+	return _VehicleId.Contract.Name(&_VehicleId.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _VehicleId.Contract.OwnerOf(&_VehicleId.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_VehicleId *VehicleIdCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _VehicleId.Contract.OwnerOf(&_VehicleId.CallOpts, tokenId)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_VehicleId *VehicleIdCaller) PrivilegeEntry(opts *bind.CallOpts, arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "privilegeEntry", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_VehicleId *VehicleIdSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.PrivilegeEntry(&_VehicleId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeEntry is a free data retrieval call binding the contract method 0x48db4640.
+//
+// Solidity: function privilegeEntry(uint256 , uint256 , uint256 , address ) view returns(uint256)
+func (_VehicleId *VehicleIdCallerSession) PrivilegeEntry(arg0 *big.Int, arg1 *big.Int, arg2 *big.Int, arg3 common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.PrivilegeEntry(&_VehicleId.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_VehicleId *VehicleIdCaller) PrivilegeExpiresAt(opts *bind.CallOpts, tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "privilegeExpiresAt", tokenId, privId, user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_VehicleId *VehicleIdSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.PrivilegeExpiresAt(&_VehicleId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeExpiresAt is a free data retrieval call binding the contract method 0xd0f8f5f6.
+//
+// Solidity: function privilegeExpiresAt(uint256 tokenId, uint256 privId, address user) view returns(uint256)
+func (_VehicleId *VehicleIdCallerSession) PrivilegeExpiresAt(tokenId *big.Int, privId *big.Int, user common.Address) (*big.Int, error) {
+	return _VehicleId.Contract.PrivilegeExpiresAt(&_VehicleId.CallOpts, tokenId, privId, user)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_VehicleId *VehicleIdCaller) PrivilegeRecord(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "privilegeRecord", arg0)
+
+	outstruct := new(struct {
+		Enabled     bool
+		Description string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Enabled = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Description = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_VehicleId *VehicleIdSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _VehicleId.Contract.PrivilegeRecord(&_VehicleId.CallOpts, arg0)
+}
+
+// PrivilegeRecord is a free data retrieval call binding the contract method 0xf9ad3efe.
+//
+// Solidity: function privilegeRecord(uint256 ) view returns(bool enabled, string description)
+func (_VehicleId *VehicleIdCallerSession) PrivilegeRecord(arg0 *big.Int) (struct {
+	Enabled     bool
+	Description string
+}, error) {
+	return _VehicleId.Contract.PrivilegeRecord(&_VehicleId.CallOpts, arg0)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_VehicleId *VehicleIdCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_VehicleId *VehicleIdSession) ProxiableUUID() ([32]byte, error) {
+	return _VehicleId.Contract.ProxiableUUID(&_VehicleId.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_VehicleId *VehicleIdCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _VehicleId.Contract.ProxiableUUID(&_VehicleId.CallOpts)
+}
+
+// Sacd is a free data retrieval call binding the contract method 0x18973db8.
+//
+// Solidity: function sacd() view returns(address)
+func (_VehicleId *VehicleIdCaller) Sacd(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "sacd")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Sacd is a free data retrieval call binding the contract method 0x18973db8.
+//
+// Solidity: function sacd() view returns(address)
+func (_VehicleId *VehicleIdSession) Sacd() (common.Address, error) {
+	return _VehicleId.Contract.Sacd(&_VehicleId.CallOpts)
+}
+
+// Sacd is a free data retrieval call binding the contract method 0x18973db8.
+//
+// Solidity: function sacd() view returns(address)
+func (_VehicleId *VehicleIdCallerSession) Sacd() (common.Address, error) {
+	return _VehicleId.Contract.Sacd(&_VehicleId.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VehicleId *VehicleIdCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VehicleId *VehicleIdSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VehicleId.Contract.SupportsInterface(&_VehicleId.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _VehicleId.Contract.SupportsInterface(&_VehicleId.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VehicleId *VehicleIdCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VehicleId *VehicleIdSession) Symbol() (string, error) {
+	return _VehicleId.Contract.Symbol(&_VehicleId.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_VehicleId *VehicleIdCallerSession) Symbol() (string, error) {
+	return _VehicleId.Contract.Symbol(&_VehicleId.CallOpts)
+}
+
+// SyntheticDeviceId is a free data retrieval call binding the contract method 0x8ea5f4cd.
+//
+// Solidity: function syntheticDeviceId() view returns(address)
+func (_VehicleId *VehicleIdCaller) SyntheticDeviceId(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "syntheticDeviceId")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SyntheticDeviceId is a free data retrieval call binding the contract method 0x8ea5f4cd.
+//
+// Solidity: function syntheticDeviceId() view returns(address)
+func (_VehicleId *VehicleIdSession) SyntheticDeviceId() (common.Address, error) {
+	return _VehicleId.Contract.SyntheticDeviceId(&_VehicleId.CallOpts)
+}
+
+// SyntheticDeviceId is a free data retrieval call binding the contract method 0x8ea5f4cd.
+//
+// Solidity: function syntheticDeviceId() view returns(address)
+func (_VehicleId *VehicleIdCallerSession) SyntheticDeviceId() (common.Address, error) {
+	return _VehicleId.Contract.SyntheticDeviceId(&_VehicleId.CallOpts)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_VehicleId *VehicleIdCaller) TokenIdToVersion(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "tokenIdToVersion", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_VehicleId *VehicleIdSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _VehicleId.Contract.TokenIdToVersion(&_VehicleId.CallOpts, arg0)
+}
+
+// TokenIdToVersion is a free data retrieval call binding the contract method 0xf1a9d41c.
+//
+// Solidity: function tokenIdToVersion(uint256 ) view returns(uint256)
+func (_VehicleId *VehicleIdCallerSession) TokenIdToVersion(arg0 *big.Int) (*big.Int, error) {
+	return _VehicleId.Contract.TokenIdToVersion(&_VehicleId.CallOpts, arg0)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_VehicleId *VehicleIdCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_VehicleId *VehicleIdSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _VehicleId.Contract.TokenURI(&_VehicleId.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_VehicleId *VehicleIdCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _VehicleId.Contract.TokenURI(&_VehicleId.CallOpts, tokenId)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_VehicleId *VehicleIdCaller) TrustedForwarders(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _VehicleId.contract.Call(opts, &out, "trustedForwarders", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_VehicleId *VehicleIdSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _VehicleId.Contract.TrustedForwarders(&_VehicleId.CallOpts, arg0)
+}
+
+// TrustedForwarders is a free data retrieval call binding the contract method 0x54776bb9.
+//
+// Solidity: function trustedForwarders(address ) view returns(bool)
+func (_VehicleId *VehicleIdCallerSession) TrustedForwarders(arg0 common.Address) (bool, error) {
+	return _VehicleId.Contract.TrustedForwarders(&_VehicleId.CallOpts, arg0)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.Approve(&_VehicleId.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.Approve(&_VehicleId.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_VehicleId *VehicleIdSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.Burn(&_VehicleId.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.Burn(&_VehicleId.TransactOpts, tokenId)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_VehicleId *VehicleIdTransactor) CreatePrivilege(opts *bind.TransactOpts, enabled bool, description string) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "createPrivilege", enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_VehicleId *VehicleIdSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _VehicleId.Contract.CreatePrivilege(&_VehicleId.TransactOpts, enabled, description)
+}
+
+// CreatePrivilege is a paid mutator transaction binding the contract method 0xc1d58b3b.
+//
+// Solidity: function createPrivilege(bool enabled, string description) returns()
+func (_VehicleId *VehicleIdTransactorSession) CreatePrivilege(enabled bool, description string) (*types.Transaction, error) {
+	return _VehicleId.Contract.CreatePrivilege(&_VehicleId.TransactOpts, enabled, description)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdTransactor) DisablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "disablePrivilege", privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.DisablePrivilege(&_VehicleId.TransactOpts, privId)
+}
+
+// DisablePrivilege is a paid mutator transaction binding the contract method 0x1a153ed0.
+//
+// Solidity: function disablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdTransactorSession) DisablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.DisablePrivilege(&_VehicleId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdTransactor) EnablePrivilege(opts *bind.TransactOpts, privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "enablePrivilege", privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.EnablePrivilege(&_VehicleId.TransactOpts, privId)
+}
+
+// EnablePrivilege is a paid mutator transaction binding the contract method 0x831ba696.
+//
+// Solidity: function enablePrivilege(uint256 privId) returns()
+func (_VehicleId *VehicleIdTransactorSession) EnablePrivilege(privId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.EnablePrivilege(&_VehicleId.TransactOpts, privId)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.GrantRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.GrantRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6b2ab28a.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address syntheticDeviceId_, address sacd_, address[] trustedForwarders_) returns()
+func (_VehicleId *VehicleIdTransactor) Initialize(opts *bind.TransactOpts, name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, syntheticDeviceId_ common.Address, sacd_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "initialize", name_, symbol_, baseUri_, dimoRegistry_, syntheticDeviceId_, sacd_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6b2ab28a.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address syntheticDeviceId_, address sacd_, address[] trustedForwarders_) returns()
+func (_VehicleId *VehicleIdSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, syntheticDeviceId_ common.Address, sacd_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.Initialize(&_VehicleId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, syntheticDeviceId_, sacd_, trustedForwarders_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x6b2ab28a.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseUri_, address dimoRegistry_, address syntheticDeviceId_, address sacd_, address[] trustedForwarders_) returns()
+func (_VehicleId *VehicleIdTransactorSession) Initialize(name_ string, symbol_ string, baseUri_ string, dimoRegistry_ common.Address, syntheticDeviceId_ common.Address, sacd_ common.Address, trustedForwarders_ []common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.Initialize(&_VehicleId.TransactOpts, name_, symbol_, baseUri_, dimoRegistry_, syntheticDeviceId_, sacd_, trustedForwarders_)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.RenounceRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.RenounceRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.RevokeRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_VehicleId *VehicleIdTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.RevokeRole(&_VehicleId.TransactOpts, role, account)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdTransactor) SafeMint(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "safeMint", to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeMint(&_VehicleId.TransactOpts, to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdTransactorSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeMint(&_VehicleId.TransactOpts, to)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdTransactor) SafeMint0(opts *bind.TransactOpts, to common.Address, uri string) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "safeMint0", to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeMint0(&_VehicleId.TransactOpts, to, uri)
+}
+
+// SafeMint0 is a paid mutator transaction binding the contract method 0xd204c45e.
+//
+// Solidity: function safeMint(address to, string uri) returns(uint256 tokenId)
+func (_VehicleId *VehicleIdTransactorSession) SafeMint0(to common.Address, uri string) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeMint0(&_VehicleId.TransactOpts, to, uri)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeTransferFrom(&_VehicleId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeTransferFrom(&_VehicleId.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_VehicleId *VehicleIdTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_VehicleId *VehicleIdSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeTransferFrom0(&_VehicleId.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_VehicleId *VehicleIdTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _VehicleId.Contract.SafeTransferFrom0(&_VehicleId.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_VehicleId *VehicleIdTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_VehicleId *VehicleIdSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetApprovalForAll(&_VehicleId.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetApprovalForAll(&_VehicleId.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_VehicleId *VehicleIdTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI_ string) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setBaseURI", baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_VehicleId *VehicleIdSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetBaseURI(&_VehicleId.TransactOpts, baseURI_)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI_) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetBaseURI(baseURI_ string) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetBaseURI(&_VehicleId.TransactOpts, baseURI_)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactor) SetDimoRegistryAddress(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setDimoRegistryAddress", addr)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_VehicleId *VehicleIdSession) SetDimoRegistryAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetDimoRegistryAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetDimoRegistryAddress is a paid mutator transaction binding the contract method 0x0db857ea.
+//
+// Solidity: function setDimoRegistryAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetDimoRegistryAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetDimoRegistryAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_VehicleId *VehicleIdTransactor) SetPrivilege(opts *bind.TransactOpts, tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setPrivilege", tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_VehicleId *VehicleIdSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetPrivilege(&_VehicleId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivilege is a paid mutator transaction binding the contract method 0xeca3221a.
+//
+// Solidity: function setPrivilege(uint256 tokenId, uint256 privId, address user, uint256 expires) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetPrivilege(tokenId *big.Int, privId *big.Int, user common.Address, expires *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetPrivilege(&_VehicleId.TransactOpts, tokenId, privId, user, expires)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_VehicleId *VehicleIdTransactor) SetPrivileges(opts *bind.TransactOpts, privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setPrivileges", privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_VehicleId *VehicleIdSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetPrivileges(&_VehicleId.TransactOpts, privData)
+}
+
+// SetPrivileges is a paid mutator transaction binding the contract method 0x57ae9754.
+//
+// Solidity: function setPrivileges((uint256,uint256,address,uint256)[] privData) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetPrivileges(privData []MultiPrivilegeSetPrivilegeData) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetPrivileges(&_VehicleId.TransactOpts, privData)
+}
+
+// SetSacd is a paid mutator transaction binding the contract method 0x75209e38.
+//
+// Solidity: function setSacd(uint256 tokenId, (address,uint256,uint256,string) sacdInput) returns()
+func (_VehicleId *VehicleIdTransactor) SetSacd(opts *bind.TransactOpts, tokenId *big.Int, sacdInput VehicleIdSacdInput) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setSacd", tokenId, sacdInput)
+}
+
+// SetSacd is a paid mutator transaction binding the contract method 0x75209e38.
+//
+// Solidity: function setSacd(uint256 tokenId, (address,uint256,uint256,string) sacdInput) returns()
+func (_VehicleId *VehicleIdSession) SetSacd(tokenId *big.Int, sacdInput VehicleIdSacdInput) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSacd(&_VehicleId.TransactOpts, tokenId, sacdInput)
+}
+
+// SetSacd is a paid mutator transaction binding the contract method 0x75209e38.
+//
+// Solidity: function setSacd(uint256 tokenId, (address,uint256,uint256,string) sacdInput) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetSacd(tokenId *big.Int, sacdInput VehicleIdSacdInput) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSacd(&_VehicleId.TransactOpts, tokenId, sacdInput)
+}
+
+// SetSacdAddress is a paid mutator transaction binding the contract method 0x555b4c8a.
+//
+// Solidity: function setSacdAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactor) SetSacdAddress(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setSacdAddress", addr)
+}
+
+// SetSacdAddress is a paid mutator transaction binding the contract method 0x555b4c8a.
+//
+// Solidity: function setSacdAddress(address addr) returns()
+func (_VehicleId *VehicleIdSession) SetSacdAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSacdAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetSacdAddress is a paid mutator transaction binding the contract method 0x555b4c8a.
+//
+// Solidity: function setSacdAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetSacdAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSacdAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetSyntheticDeviceIdAddress is a paid mutator transaction binding the contract method 0xaa531530.
+//
+// Solidity: function setSyntheticDeviceIdAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactor) SetSyntheticDeviceIdAddress(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setSyntheticDeviceIdAddress", addr)
+}
+
+// SetSyntheticDeviceIdAddress is a paid mutator transaction binding the contract method 0xaa531530.
+//
+// Solidity: function setSyntheticDeviceIdAddress(address addr) returns()
+func (_VehicleId *VehicleIdSession) SetSyntheticDeviceIdAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSyntheticDeviceIdAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetSyntheticDeviceIdAddress is a paid mutator transaction binding the contract method 0xaa531530.
+//
+// Solidity: function setSyntheticDeviceIdAddress(address addr) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetSyntheticDeviceIdAddress(addr common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetSyntheticDeviceIdAddress(&_VehicleId.TransactOpts, addr)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_VehicleId *VehicleIdTransactor) SetTrustedForwarder(opts *bind.TransactOpts, addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "setTrustedForwarder", addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_VehicleId *VehicleIdSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetTrustedForwarder(&_VehicleId.TransactOpts, addr, trusted)
+}
+
+// SetTrustedForwarder is a paid mutator transaction binding the contract method 0xe691d03b.
+//
+// Solidity: function setTrustedForwarder(address addr, bool trusted) returns()
+func (_VehicleId *VehicleIdTransactorSession) SetTrustedForwarder(addr common.Address, trusted bool) (*types.Transaction, error) {
+	return _VehicleId.Contract.SetTrustedForwarder(&_VehicleId.TransactOpts, addr, trusted)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.TransferFrom(&_VehicleId.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_VehicleId *VehicleIdTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _VehicleId.Contract.TransferFrom(&_VehicleId.TransactOpts, from, to, tokenId)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_VehicleId *VehicleIdTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_VehicleId *VehicleIdSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.UpgradeTo(&_VehicleId.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_VehicleId *VehicleIdTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _VehicleId.Contract.UpgradeTo(&_VehicleId.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_VehicleId *VehicleIdTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _VehicleId.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_VehicleId *VehicleIdSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _VehicleId.Contract.UpgradeToAndCall(&_VehicleId.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_VehicleId *VehicleIdTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _VehicleId.Contract.UpgradeToAndCall(&_VehicleId.TransactOpts, newImplementation, data)
+}
+
+// VehicleIdAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the VehicleId contract.
+type VehicleIdAdminChangedIterator struct {
+	Event *VehicleIdAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdAdminChanged represents a AdminChanged event raised by the VehicleId contract.
+type VehicleIdAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_VehicleId *VehicleIdFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*VehicleIdAdminChangedIterator, error) {
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdAdminChangedIterator{contract: _VehicleId.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_VehicleId *VehicleIdFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *VehicleIdAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdAdminChanged)
+				if err := _VehicleId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_VehicleId *VehicleIdFilterer) ParseAdminChanged(log types.Log) (*VehicleIdAdminChanged, error) {
+	event := new(VehicleIdAdminChanged)
+	if err := _VehicleId.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the VehicleId contract.
+type VehicleIdApprovalIterator struct {
+	Event *VehicleIdApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdApproval represents a Approval event raised by the VehicleId contract.
+type VehicleIdApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*VehicleIdApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdApprovalIterator{contract: _VehicleId.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *VehicleIdApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdApproval)
+				if err := _VehicleId.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) ParseApproval(log types.Log) (*VehicleIdApproval, error) {
+	event := new(VehicleIdApproval)
+	if err := _VehicleId.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the VehicleId contract.
+type VehicleIdApprovalForAllIterator struct {
+	Event *VehicleIdApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdApprovalForAll represents a ApprovalForAll event raised by the VehicleId contract.
+type VehicleIdApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_VehicleId *VehicleIdFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*VehicleIdApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdApprovalForAllIterator{contract: _VehicleId.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_VehicleId *VehicleIdFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *VehicleIdApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdApprovalForAll)
+				if err := _VehicleId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_VehicleId *VehicleIdFilterer) ParseApprovalForAll(log types.Log) (*VehicleIdApprovalForAll, error) {
+	event := new(VehicleIdApprovalForAll)
+	if err := _VehicleId.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the VehicleId contract.
+type VehicleIdBatchMetadataUpdateIterator struct {
+	Event *VehicleIdBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the VehicleId contract.
+type VehicleIdBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_VehicleId *VehicleIdFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*VehicleIdBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdBatchMetadataUpdateIterator{contract: _VehicleId.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_VehicleId *VehicleIdFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *VehicleIdBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdBatchMetadataUpdate)
+				if err := _VehicleId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_VehicleId *VehicleIdFilterer) ParseBatchMetadataUpdate(log types.Log) (*VehicleIdBatchMetadataUpdate, error) {
+	event := new(VehicleIdBatchMetadataUpdate)
+	if err := _VehicleId.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the VehicleId contract.
+type VehicleIdBeaconUpgradedIterator struct {
+	Event *VehicleIdBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdBeaconUpgraded represents a BeaconUpgraded event raised by the VehicleId contract.
+type VehicleIdBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_VehicleId *VehicleIdFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*VehicleIdBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdBeaconUpgradedIterator{contract: _VehicleId.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_VehicleId *VehicleIdFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *VehicleIdBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdBeaconUpgraded)
+				if err := _VehicleId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_VehicleId *VehicleIdFilterer) ParseBeaconUpgraded(log types.Log) (*VehicleIdBeaconUpgraded, error) {
+	event := new(VehicleIdBeaconUpgraded)
+	if err := _VehicleId.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the VehicleId contract.
+type VehicleIdInitializedIterator struct {
+	Event *VehicleIdInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdInitialized represents a Initialized event raised by the VehicleId contract.
+type VehicleIdInitialized struct {
+	Version uint8
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_VehicleId *VehicleIdFilterer) FilterInitialized(opts *bind.FilterOpts) (*VehicleIdInitializedIterator, error) {
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdInitializedIterator{contract: _VehicleId.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_VehicleId *VehicleIdFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *VehicleIdInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdInitialized)
+				if err := _VehicleId.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498.
+//
+// Solidity: event Initialized(uint8 version)
+func (_VehicleId *VehicleIdFilterer) ParseInitialized(log types.Log) (*VehicleIdInitialized, error) {
+	event := new(VehicleIdInitialized)
+	if err := _VehicleId.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the VehicleId contract.
+type VehicleIdMetadataUpdateIterator struct {
+	Event *VehicleIdMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdMetadataUpdate represents a MetadataUpdate event raised by the VehicleId contract.
+type VehicleIdMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_VehicleId *VehicleIdFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*VehicleIdMetadataUpdateIterator, error) {
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdMetadataUpdateIterator{contract: _VehicleId.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_VehicleId *VehicleIdFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *VehicleIdMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdMetadataUpdate)
+				if err := _VehicleId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_VehicleId *VehicleIdFilterer) ParseMetadataUpdate(log types.Log) (*VehicleIdMetadataUpdate, error) {
+	event := new(VehicleIdMetadataUpdate)
+	if err := _VehicleId.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdPrivilegeCreatedIterator is returned from FilterPrivilegeCreated and is used to iterate over the raw logs and unpacked data for PrivilegeCreated events raised by the VehicleId contract.
+type VehicleIdPrivilegeCreatedIterator struct {
+	Event *VehicleIdPrivilegeCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdPrivilegeCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdPrivilegeCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdPrivilegeCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdPrivilegeCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdPrivilegeCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdPrivilegeCreated represents a PrivilegeCreated event raised by the VehicleId contract.
+type VehicleIdPrivilegeCreated struct {
+	PrivilegeId *big.Int
+	Enabled     bool
+	Description string
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeCreated is a free log retrieval operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_VehicleId *VehicleIdFilterer) FilterPrivilegeCreated(opts *bind.FilterOpts, privilegeId []*big.Int) (*VehicleIdPrivilegeCreatedIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdPrivilegeCreatedIterator{contract: _VehicleId.contract, event: "PrivilegeCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeCreated is a free log subscription operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_VehicleId *VehicleIdFilterer) WatchPrivilegeCreated(opts *bind.WatchOpts, sink chan<- *VehicleIdPrivilegeCreated, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "PrivilegeCreated", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdPrivilegeCreated)
+				if err := _VehicleId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeCreated is a log parse operation binding the contract event 0x6b1e285f7a3f24ce57e873fa5f9150dfc8a8f24f4feb1e581d42f678fa1b40b3.
+//
+// Solidity: event PrivilegeCreated(uint256 indexed privilegeId, bool enabled, string description)
+func (_VehicleId *VehicleIdFilterer) ParsePrivilegeCreated(log types.Log) (*VehicleIdPrivilegeCreated, error) {
+	event := new(VehicleIdPrivilegeCreated)
+	if err := _VehicleId.contract.UnpackLog(event, "PrivilegeCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdPrivilegeDisabledIterator is returned from FilterPrivilegeDisabled and is used to iterate over the raw logs and unpacked data for PrivilegeDisabled events raised by the VehicleId contract.
+type VehicleIdPrivilegeDisabledIterator struct {
+	Event *VehicleIdPrivilegeDisabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdPrivilegeDisabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdPrivilegeDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdPrivilegeDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdPrivilegeDisabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdPrivilegeDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdPrivilegeDisabled represents a PrivilegeDisabled event raised by the VehicleId contract.
+type VehicleIdPrivilegeDisabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeDisabled is a free log retrieval operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) FilterPrivilegeDisabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*VehicleIdPrivilegeDisabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdPrivilegeDisabledIterator{contract: _VehicleId.contract, event: "PrivilegeDisabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeDisabled is a free log subscription operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) WatchPrivilegeDisabled(opts *bind.WatchOpts, sink chan<- *VehicleIdPrivilegeDisabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "PrivilegeDisabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdPrivilegeDisabled)
+				if err := _VehicleId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeDisabled is a log parse operation binding the contract event 0xd0a5bf4add1e8e17a93542ca7a6aa2a541ed2da0cd3f76b8b799e7b698f8a633.
+//
+// Solidity: event PrivilegeDisabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) ParsePrivilegeDisabled(log types.Log) (*VehicleIdPrivilegeDisabled, error) {
+	event := new(VehicleIdPrivilegeDisabled)
+	if err := _VehicleId.contract.UnpackLog(event, "PrivilegeDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdPrivilegeEnabledIterator is returned from FilterPrivilegeEnabled and is used to iterate over the raw logs and unpacked data for PrivilegeEnabled events raised by the VehicleId contract.
+type VehicleIdPrivilegeEnabledIterator struct {
+	Event *VehicleIdPrivilegeEnabled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdPrivilegeEnabledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdPrivilegeEnabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdPrivilegeEnabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdPrivilegeEnabledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdPrivilegeEnabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdPrivilegeEnabled represents a PrivilegeEnabled event raised by the VehicleId contract.
+type VehicleIdPrivilegeEnabled struct {
+	PrivilegeId *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeEnabled is a free log retrieval operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) FilterPrivilegeEnabled(opts *bind.FilterOpts, privilegeId []*big.Int) (*VehicleIdPrivilegeEnabledIterator, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdPrivilegeEnabledIterator{contract: _VehicleId.contract, event: "PrivilegeEnabled", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeEnabled is a free log subscription operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) WatchPrivilegeEnabled(opts *bind.WatchOpts, sink chan<- *VehicleIdPrivilegeEnabled, privilegeId []*big.Int) (event.Subscription, error) {
+
+	var privilegeIdRule []interface{}
+	for _, privilegeIdItem := range privilegeId {
+		privilegeIdRule = append(privilegeIdRule, privilegeIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "PrivilegeEnabled", privilegeIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdPrivilegeEnabled)
+				if err := _VehicleId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeEnabled is a log parse operation binding the contract event 0xdb76175b0679b36e2d84207a86ca82a64ed1fd1af72c1a48eb3ff9369bbc4c83.
+//
+// Solidity: event PrivilegeEnabled(uint256 indexed privilegeId)
+func (_VehicleId *VehicleIdFilterer) ParsePrivilegeEnabled(log types.Log) (*VehicleIdPrivilegeEnabled, error) {
+	event := new(VehicleIdPrivilegeEnabled)
+	if err := _VehicleId.contract.UnpackLog(event, "PrivilegeEnabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdPrivilegeSetIterator is returned from FilterPrivilegeSet and is used to iterate over the raw logs and unpacked data for PrivilegeSet events raised by the VehicleId contract.
+type VehicleIdPrivilegeSetIterator struct {
+	Event *VehicleIdPrivilegeSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdPrivilegeSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdPrivilegeSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdPrivilegeSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdPrivilegeSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdPrivilegeSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdPrivilegeSet represents a PrivilegeSet event raised by the VehicleId contract.
+type VehicleIdPrivilegeSet struct {
+	TokenId *big.Int
+	Version *big.Int
+	PrivId  *big.Int
+	User    common.Address
+	Expires *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrivilegeSet is a free log retrieval operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_VehicleId *VehicleIdFilterer) FilterPrivilegeSet(opts *bind.FilterOpts, tokenId []*big.Int, privId []*big.Int, user []common.Address) (*VehicleIdPrivilegeSetIterator, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdPrivilegeSetIterator{contract: _VehicleId.contract, event: "PrivilegeSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPrivilegeSet is a free log subscription operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_VehicleId *VehicleIdFilterer) WatchPrivilegeSet(opts *bind.WatchOpts, sink chan<- *VehicleIdPrivilegeSet, tokenId []*big.Int, privId []*big.Int, user []common.Address) (event.Subscription, error) {
+
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	var privIdRule []interface{}
+	for _, privIdItem := range privId {
+		privIdRule = append(privIdRule, privIdItem)
+	}
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "PrivilegeSet", tokenIdRule, privIdRule, userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdPrivilegeSet)
+				if err := _VehicleId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrivilegeSet is a log parse operation binding the contract event 0x61a24679288162b799d80b2bb2b8b0fcdd5c5f53ac19e9246cc190b60196c359.
+//
+// Solidity: event PrivilegeSet(uint256 indexed tokenId, uint256 version, uint256 indexed privId, address indexed user, uint256 expires)
+func (_VehicleId *VehicleIdFilterer) ParsePrivilegeSet(log types.Log) (*VehicleIdPrivilegeSet, error) {
+	event := new(VehicleIdPrivilegeSet)
+	if err := _VehicleId.contract.UnpackLog(event, "PrivilegeSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the VehicleId contract.
+type VehicleIdRoleAdminChangedIterator struct {
+	Event *VehicleIdRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdRoleAdminChanged represents a RoleAdminChanged event raised by the VehicleId contract.
+type VehicleIdRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VehicleId *VehicleIdFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*VehicleIdRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdRoleAdminChangedIterator{contract: _VehicleId.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VehicleId *VehicleIdFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *VehicleIdRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdRoleAdminChanged)
+				if err := _VehicleId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_VehicleId *VehicleIdFilterer) ParseRoleAdminChanged(log types.Log) (*VehicleIdRoleAdminChanged, error) {
+	event := new(VehicleIdRoleAdminChanged)
+	if err := _VehicleId.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the VehicleId contract.
+type VehicleIdRoleGrantedIterator struct {
+	Event *VehicleIdRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdRoleGranted represents a RoleGranted event raised by the VehicleId contract.
+type VehicleIdRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VehicleIdRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdRoleGrantedIterator{contract: _VehicleId.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *VehicleIdRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdRoleGranted)
+				if err := _VehicleId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) ParseRoleGranted(log types.Log) (*VehicleIdRoleGranted, error) {
+	event := new(VehicleIdRoleGranted)
+	if err := _VehicleId.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the VehicleId contract.
+type VehicleIdRoleRevokedIterator struct {
+	Event *VehicleIdRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdRoleRevoked represents a RoleRevoked event raised by the VehicleId contract.
+type VehicleIdRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*VehicleIdRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdRoleRevokedIterator{contract: _VehicleId.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *VehicleIdRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdRoleRevoked)
+				if err := _VehicleId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_VehicleId *VehicleIdFilterer) ParseRoleRevoked(log types.Log) (*VehicleIdRoleRevoked, error) {
+	event := new(VehicleIdRoleRevoked)
+	if err := _VehicleId.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the VehicleId contract.
+type VehicleIdTransferIterator struct {
+	Event *VehicleIdTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdTransfer represents a Transfer event raised by the VehicleId contract.
+type VehicleIdTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*VehicleIdTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdTransferIterator{contract: _VehicleId.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *VehicleIdTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdTransfer)
+				if err := _VehicleId.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_VehicleId *VehicleIdFilterer) ParseTransfer(log types.Log) (*VehicleIdTransfer, error) {
+	event := new(VehicleIdTransfer)
+	if err := _VehicleId.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// VehicleIdUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the VehicleId contract.
+type VehicleIdUpgradedIterator struct {
+	Event *VehicleIdUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VehicleIdUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VehicleIdUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VehicleIdUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VehicleIdUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VehicleIdUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VehicleIdUpgraded represents a Upgraded event raised by the VehicleId contract.
+type VehicleIdUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_VehicleId *VehicleIdFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*VehicleIdUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleIdUpgradedIterator{contract: _VehicleId.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_VehicleId *VehicleIdFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *VehicleIdUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _VehicleId.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VehicleIdUpgraded)
+				if err := _VehicleId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_VehicleId *VehicleIdFilterer) ParseUpgraded(log types.Log) (*VehicleIdUpgraded, error) {
+	event := new(VehicleIdUpgraded)
+	if err := _VehicleId.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,367 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/aftermarketDeviceId"
+)
+
+// ErrAftermarketDeviceNotMinted is returned when a mint or reprovision
+// receipt has no AftermarketDeviceNodeMinted log.
+var ErrAftermarketDeviceNotMinted = errors.New("client: no AftermarketDeviceNodeMinted log in receipt")
+
+// AftermarketDeviceState is where an aftermarket device is in its lifecycle.
+type AftermarketDeviceState int
+
+const (
+	// AftermarketDeviceBurned is a token that does not exist, because it was
+	// burned, reprovisioned or never minted.
+	AftermarketDeviceBurned AftermarketDeviceState = iota
+	// AftermarketDeviceMinted is a device minted, or returned, to its
+	// manufacturer and waiting to be claimed.
+	AftermarketDeviceMinted
+	// AftermarketDeviceClaimed is a device claimed by its owner and not
+	// paired with a vehicle.
+	AftermarketDeviceClaimed
+	// AftermarketDevicePaired is a claimed device paired with a vehicle.
+	AftermarketDevicePaired
+)
+
+func (s AftermarketDeviceState) String() string {
+	switch s {
+	case AftermarketDeviceBurned:
+		return "burned"
+	case AftermarketDeviceMinted:
+		return "minted"
+	case AftermarketDeviceClaimed:
+		return "claimed"
+	case AftermarketDevicePaired:
+		return "paired"
+	default:
+		return fmt.Sprintf("AftermarketDeviceState(%d)", int(s))
+	}
+}
+
+// AftermarketDeviceTransition is an operation that moves an aftermarket
+// device to another state.
+type AftermarketDeviceTransition string
+
+// Transitions of an aftermarket device.
+const (
+	TransitionClaim         AftermarketDeviceTransition = "claim"
+	TransitionPair          AftermarketDeviceTransition = "pair"
+	TransitionUnpair        AftermarketDeviceTransition = "unpair"
+	TransitionReturn        AftermarketDeviceTransition = "return to manufacturer"
+	TransitionReprovision   AftermarketDeviceTransition = "reprovision"
+	TransitionBurn          AftermarketDeviceTransition = "burn"
+	TransitionBurnAndUnpair AftermarketDeviceTransition = "burn and unpair"
+)
+
+// aftermarketDeviceTransitions lists the transitions the registry accepts
+// from each state.
+var aftermarketDeviceTransitions = map[AftermarketDeviceState][]AftermarketDeviceTransition{
+	AftermarketDeviceMinted:  {TransitionClaim, TransitionReprovision, TransitionBurn, TransitionBurnAndUnpair},
+	AftermarketDeviceClaimed: {TransitionPair, TransitionReturn, TransitionReprovision, TransitionBurn, TransitionBurnAndUnpair},
+	AftermarketDevicePaired:  {TransitionUnpair, TransitionReturn, TransitionReprovision, TransitionBurnAndUnpair},
+}
+
+// InvalidTransitionError is returned, without sending a transaction, for a
+// transition that is not valid from the device's state and would revert
+// on-chain.
+type InvalidTransitionError struct {
+	TokenID    *big.Int
+	State      AftermarketDeviceState
+	Transition AftermarketDeviceTransition
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("client: cannot %s aftermarket device %s in state %s", e.Transition, e.TokenID, e.State)
+}
+
+// AftermarketDeviceClient reads aftermarket devices and moves them through
+// their lifecycle through the DIMORegistry. Every method waits for its
+// transaction to be mined.
+type AftermarketDeviceClient struct {
+	address     common.Address
+	adIdAddress common.Address
+	registry    *contracts.Registry
+	adId        *aftermarketDeviceId.AftermarketDeviceId
+	backend     Backend
+}
+
+// NewAftermarketDeviceClient returns an AftermarketDeviceClient for the
+// registry deployed at address and the AftermarketDeviceId proxy at
+// adIdAddress.
+func NewAftermarketDeviceClient(address, adIdAddress common.Address, backend Backend) (*AftermarketDeviceClient, error) {
+	registry, err := contracts.NewRegistry(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	adId, err := aftermarketDeviceId.NewAftermarketDeviceId(adIdAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AftermarketDeviceClient{
+		address:     address,
+		adIdAddress: adIdAddress,
+		registry:    registry,
+		adId:        adId,
+		backend:     backend,
+	}, nil
+}
+
+// Mint mints devices under manufacturerNode and returns their token ids, in
+// the order of infos. The sender must own manufacturerNode or have its
+// minting privilege.
+//
+// Solidity: mintAftermarketDeviceByManufacturerBatch(uint256,(address,(string,string)[])[])
+func (c *AftermarketDeviceClient) Mint(opts *bind.TransactOpts, manufacturerNode *big.Int, infos []contracts.AftermarketDeviceInfos) ([]*big.Int, error) {
+	tx, err := c.registry.MintAftermarketDeviceByManufacturerBatch(opts, manufacturerNode, infos)
+	receipt, err := waitMined(opts, c.backend, tx, err)
+	if err != nil {
+		return nil, err
+	}
+	ids := c.mintedIds(receipt)
+	if len(ids) == 0 {
+		return nil, ErrAftermarketDeviceNotMinted
+	}
+	return ids, nil
+}
+
+// Get reads the current state of the device tokenId.
+func (c *AftermarketDeviceClient) Get(opts *bind.CallOpts, tokenId *big.Int) (*AftermarketDevice, error) {
+	d := &AftermarketDevice{TokenID: tokenId, client: c}
+	if err := d.Refresh(opts); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// mintedIds returns the token ids of the AftermarketDeviceNodeMinted logs of
+// receipt.
+func (c *AftermarketDeviceClient) mintedIds(receipt *types.Receipt) []*big.Int {
+	var ids []*big.Int
+	for _, log := range receipt.Logs {
+		if log.Address != c.address {
+			continue
+		}
+		ev, err := c.registry.ParseAftermarketDeviceNodeMinted(*log)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, ev.TokenId)
+	}
+	return ids
+}
+
+// AftermarketDevice is a snapshot of an aftermarket device. Its transition
+// methods check the snapshot's state before sending anything, and refresh it
+// once their transaction is mined. A device is not safe for concurrent use.
+type AftermarketDevice struct {
+	TokenID *big.Int
+	State   AftermarketDeviceState
+	// Owner and Address are the token owner and the device's own address,
+	// zero when the device is burned.
+	Owner   common.Address
+	Address common.Address
+	// Vehicle is the paired vehicle node, nil unless the device is paired.
+	Vehicle *big.Int
+
+	client *AftermarketDeviceClient
+}
+
+// Refresh reads the device's state again.
+func (d *AftermarketDevice) Refresh(opts *bind.CallOpts) error {
+	c := d.client
+	exists, err := c.adId.Exists(opts, d.TokenID)
+	if err != nil {
+		return err
+	}
+	d.State, d.Owner, d.Address, d.Vehicle = AftermarketDeviceBurned, common.Address{}, common.Address{}, nil
+	if !exists {
+		return nil
+	}
+
+	if d.Owner, err = c.adId.OwnerOf(opts, d.TokenID); err != nil {
+		return err
+	}
+	if d.Address, err = c.registry.GetAftermarketDeviceAddressById(opts, d.TokenID); err != nil {
+		return err
+	}
+	claimed, err := c.registry.IsAftermarketDeviceClaimed(opts, d.TokenID)
+	if err != nil {
+		return err
+	}
+	vehicle, err := c.registry.GetLink(opts, c.adIdAddress, d.TokenID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case vehicle.Sign() != 0:
+		d.State, d.Vehicle = AftermarketDevicePaired, vehicle
+	case claimed:
+		d.State = AftermarketDeviceClaimed
+	default:
+		d.State = AftermarketDeviceMinted
+	}
+	return nil
+}
+
+// Transitions returns the transitions valid from the device's state.
+func (d *AftermarketDevice) Transitions() []AftermarketDeviceTransition {
+	return append([]AftermarketDeviceTransition(nil), aftermarketDeviceTransitions[d.State]...)
+}
+
+// Can reports whether t is valid from the device's state.
+func (d *AftermarketDevice) Can(t AftermarketDeviceTransition) bool {
+	for _, valid := range aftermarketDeviceTransitions[d.State] {
+		if valid == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *AftermarketDevice) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "aftermarket device %s (%s", d.TokenID, d.State)
+	if d.Vehicle != nil {
+		fmt.Fprintf(&b, " with vehicle %s", d.Vehicle)
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// Claim transfers a minted device to owner. Both owner and the device sign
+// a ClaimAftermarketDeviceSign message (see eip712).
+//
+// Solidity: claimAftermarketDeviceSign(uint256,address,bytes,bytes)
+func (d *AftermarketDevice) Claim(opts *bind.TransactOpts, owner common.Address, ownerSig, aftermarketDeviceSig []byte) (*types.Receipt, error) {
+	if err := d.check(TransitionClaim); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.ClaimAftermarketDeviceSign(opts, d.TokenID, owner, ownerSig, aftermarketDeviceSig)
+	return d.transition(opts, tx, err)
+}
+
+// Pair pairs a claimed device with vehicleNode. The device and the vehicle
+// owner sign a PairAftermarketDeviceSign message.
+//
+// Solidity: pairAftermarketDeviceSign(uint256,uint256,bytes,bytes)
+func (d *AftermarketDevice) Pair(opts *bind.TransactOpts, vehicleNode *big.Int, aftermarketDeviceSig, vehicleOwnerSig []byte) (*types.Receipt, error) {
+	if err := d.check(TransitionPair); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.PairAftermarketDeviceSign(opts, d.TokenID, vehicleNode, aftermarketDeviceSig, vehicleOwnerSig)
+	return d.transition(opts, tx, err)
+}
+
+// PairByOwner pairs a claimed device with vehicleNode when the same account
+// owns both, which signs a PairAftermarketDeviceSign message.
+//
+// Solidity: pairAftermarketDeviceSign(uint256,uint256,bytes)
+func (d *AftermarketDevice) PairByOwner(opts *bind.TransactOpts, vehicleNode *big.Int, ownerSig []byte) (*types.Receipt, error) {
+	if err := d.check(TransitionPair); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.PairAftermarketDeviceSign0(opts, d.TokenID, vehicleNode, ownerSig)
+	return d.transition(opts, tx, err)
+}
+
+// Unpair unpairs the device from its vehicle. The device or the vehicle
+// owner signs an UnpairAftermarketDeviceSign message.
+//
+// Solidity: unpairAftermarketDeviceSign(uint256,uint256,bytes)
+func (d *AftermarketDevice) Unpair(opts *bind.TransactOpts, signature []byte) (*types.Receipt, error) {
+	if err := d.check(TransitionUnpair); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.UnpairAftermarketDeviceSign(opts, d.TokenID, d.Vehicle, signature)
+	return d.transition(opts, tx, err)
+}
+
+// ReturnToManufacturer unpairs the device, marks it as unclaimed and
+// transfers it back to its manufacturer, through the registry's
+// resetAftermarketDeviceForClaiming. The sender must own the device.
+//
+// Solidity: AftermarketDeviceId.returnToManufacturer(uint256)
+func (d *AftermarketDevice) ReturnToManufacturer(opts *bind.TransactOpts) (*types.Receipt, error) {
+	if err := d.check(TransitionReturn); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.adId.ReturnToManufacturer(opts, d.TokenID)
+	return d.transition(opts, tx, err)
+}
+
+// Reprovision burns the device and mints it again to its manufacturer with
+// the same address and attributes, unpairing it first. It returns the new
+// token id; the device itself ends up burned. The sender must own the
+// manufacturer node or have its reprovision privilege.
+//
+// Solidity: reprovisionAftermarketDeviceByManufacturerBatch(uint256[])
+func (d *AftermarketDevice) Reprovision(opts *bind.TransactOpts) (*big.Int, error) {
+	if err := d.check(TransitionReprovision); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.ReprovisionAftermarketDeviceByManufacturerBatch(opts, []*big.Int{d.TokenID})
+	receipt, err := d.transition(opts, tx, err)
+	if err != nil {
+		return nil, err
+	}
+	ids := d.client.mintedIds(receipt)
+	if len(ids) == 0 {
+		return nil, ErrAftermarketDeviceNotMinted
+	}
+	return ids[0], nil
+}
+
+// Burn burns an unpaired device. The sender must have the DEV_AD_BURN_ROLE.
+//
+// Solidity: adminBurnAftermarketDevices(uint256[])
+func (d *AftermarketDevice) Burn(opts *bind.TransactOpts) (*types.Receipt, error) {
+	if err := d.check(TransitionBurn); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.AdminBurnAftermarketDevices(opts, []*big.Int{d.TokenID})
+	return d.transition(opts, tx, err)
+}
+
+// BurnAndUnpair burns the device, unpairing it first if needed. The sender
+// must have the DEV_AD_BURN_ROLE.
+//
+// Solidity: adminBurnAftermarketDevicesAndDeletePairings(uint256[])
+func (d *AftermarketDevice) BurnAndUnpair(opts *bind.TransactOpts) (*types.Receipt, error) {
+	if err := d.check(TransitionBurnAndUnpair); err != nil {
+		return nil, err
+	}
+	tx, err := d.client.registry.AdminBurnAftermarketDevicesAndDeletePairings(opts, []*big.Int{d.TokenID})
+	return d.transition(opts, tx, err)
+}
+
+func (d *AftermarketDevice) check(t AftermarketDeviceTransition) error {
+	if !d.Can(t) {
+		return &InvalidTransitionError{TokenID: d.TokenID, State: d.State, Transition: t}
+	}
+	return nil
+}
+
+// transition waits for a transition transaction and refreshes the device.
+func (d *AftermarketDevice) transition(opts *bind.TransactOpts, tx *types.Transaction, sendErr error) (*types.Receipt, error) {
+	receipt, err := waitMined(opts, d.client.backend, tx, sendErr)
+	if err != nil {
+		return receipt, err
+	}
+	if err := d.Refresh(&bind.CallOpts{Context: opts.Context}); err != nil {
+		return receipt, fmt.Errorf("failed to refresh %s: %w", d, err)
+	}
+	return receipt, nil
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/aftermarketDeviceId"
+)
+
+var testAdId = common.HexToAddress("0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA")
+
+// fakeDevice is the on-chain state of an aftermarket device read by Refresh.
+type fakeDevice struct {
+	exists  bool
+	owner   common.Address
+	address common.Address
+	claimed bool
+	vehicle int64
+}
+
+// deviceCalls answers the calls of Refresh from device.
+func deviceCalls(t *testing.T, device *fakeDevice) func(ethereum.CallMsg) ([]byte, error) {
+	registryABI, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	adIdABI, err := aftermarketDeviceId.AftermarketDeviceIdMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pack := func(parsed *abi.ABI, name string, values ...interface{}) ([]byte, error) {
+		return parsed.Methods[name].Outputs.Pack(values...)
+	}
+	return func(msg ethereum.CallMsg) ([]byte, error) {
+		sel := msg.Data[:4]
+		switch {
+		case *msg.To == testAdId && bytes.Equal(sel, selector("exists(uint256)")):
+			return pack(adIdABI, "exists", device.exists)
+		case *msg.To == testAdId && bytes.Equal(sel, selector("ownerOf(uint256)")):
+			return pack(adIdABI, "ownerOf", device.owner)
+		case *msg.To == testRegistry && bytes.Equal(sel, selector("getAftermarketDeviceAddressById(uint256)")):
+			return pack(registryABI, "getAftermarketDeviceAddressById", device.address)
+		case *msg.To == testRegistry && bytes.Equal(sel, selector("isAftermarketDeviceClaimed(uint256)")):
+			return pack(registryABI, "isAftermarketDeviceClaimed", device.claimed)
+		case *msg.To == testRegistry && bytes.Equal(sel, selector("getLink(address,uint256)")):
+			return pack(registryABI, "getLink", big.NewInt(device.vehicle))
+		}
+		t.Fatalf("unexpected call %x to %s", msg.Data, msg.To)
+		return nil, nil
+	}
+}
+
+func newAftermarketDeviceClient(t *testing.T, backend *fakeBackend) *AftermarketDeviceClient {
+	t.Helper()
+	c, err := NewAftermarketDeviceClient(testRegistry, testAdId, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+var (
+	deviceMinted  = fakeDevice{exists: true, owner: common.HexToAddress("0xaa"), address: common.HexToAddress("0xad")}
+	deviceClaimed = fakeDevice{exists: true, owner: common.HexToAddress("0xbb"), address: common.HexToAddress("0xad"), claimed: true}
+	devicePaired  = fakeDevice{exists: true, owner: common.HexToAddress("0xbb"), address: common.HexToAddress("0xad"), claimed: true, vehicle: 5}
+)
+
+func TestAftermarketDeviceGet(t *testing.T) {
+	for _, tt := range []struct {
+		device  fakeDevice
+		want    AftermarketDeviceState
+		vehicle *big.Int
+	}{
+		{fakeDevice{}, AftermarketDeviceBurned, nil},
+		{deviceMinted, AftermarketDeviceMinted, nil},
+		{deviceClaimed, AftermarketDeviceClaimed, nil},
+		{devicePaired, AftermarketDevicePaired, big.NewInt(5)},
+	} {
+		t.Run(tt.want.String(), func(t *testing.T) {
+			device := tt.device
+			c := newAftermarketDeviceClient(t, &fakeBackend{call: deviceCalls(t, &device)})
+			d, err := c.Get(&bind.CallOpts{}, big.NewInt(7))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.State != tt.want || d.Owner != device.owner || d.Address != device.address {
+				t.Errorf("Get() = %s owned by %s at %s, want %s owned by %s at %s", d.State, d.Owner, d.Address, tt.want, device.owner, device.address)
+			}
+			if (d.Vehicle == nil) != (tt.vehicle == nil) || (d.Vehicle != nil && d.Vehicle.Cmp(tt.vehicle) != 0) {
+				t.Errorf("Vehicle = %v, want %v", d.Vehicle, tt.vehicle)
+			}
+		})
+	}
+}
+
+// transitions calls every transition method of d.
+var transitions = map[AftermarketDeviceTransition]func(d *AftermarketDevice, opts *bind.TransactOpts) error{
+	TransitionClaim: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.Claim(opts, common.HexToAddress("0xbb"), []byte("owner"), []byte("device"))
+		return err
+	},
+	TransitionPair: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.Pair(opts, big.NewInt(5), []byte("device"), []byte("owner"))
+		return err
+	},
+	TransitionUnpair: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.Unpair(opts, []byte("owner"))
+		return err
+	},
+	TransitionReturn: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.ReturnToManufacturer(opts)
+		return err
+	},
+	TransitionReprovision: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.Reprovision(opts)
+		return err
+	},
+	TransitionBurn: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.Burn(opts)
+		return err
+	},
+	TransitionBurnAndUnpair: func(d *AftermarketDevice, opts *bind.TransactOpts) error {
+		_, err := d.BurnAndUnpair(opts)
+		return err
+	},
+}
+
+// TestAftermarketDeviceInvalidTransition checks that a transition the
+// registry would reject fails before sending anything.
+func TestAftermarketDeviceInvalidTransition(t *testing.T) {
+	for _, state := range []fakeDevice{{}, deviceMinted, deviceClaimed, devicePaired} {
+		device := state
+		backend := &fakeBackend{call: deviceCalls(t, &device)}
+		d, err := newAftermarketDeviceClient(t, backend).Get(&bind.CallOpts{}, big.NewInt(7))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for transition, call := range transitions {
+			if d.Can(transition) {
+				continue
+			}
+			opts, _ := transactOpts(t)
+			var invalid *InvalidTransitionError
+			if err := call(d, opts); !errors.As(err, &invalid) || invalid.State != d.State || invalid.Transition != transition {
+				t.Errorf("%s from %s: %v, want an InvalidTransitionError", transition, d.State, err)
+			}
+		}
+		if len(backend.sent) != 0 {
+			t.Errorf("%s: %d transactions sent for invalid transitions", d.State, len(backend.sent))
+		}
+	}
+}
+
+// TestAftermarketDeviceTransition checks that valid transitions send the
+// method named in their doc comment and refresh the device once mined.
+func TestAftermarketDeviceTransition(t *testing.T) {
+	for _, tt := range []struct {
+		transition AftermarketDeviceTransition
+		from, to   fakeDevice
+		contract   common.Address
+		signature  string
+		want       AftermarketDeviceState
+	}{
+		{TransitionClaim, deviceMinted, deviceClaimed, testRegistry, "claimAftermarketDeviceSign(uint256,address,bytes,bytes)", AftermarketDeviceClaimed},
+		{TransitionPair, deviceClaimed, devicePaired, testRegistry, "pairAftermarketDeviceSign(uint256,uint256,bytes,bytes)", AftermarketDevicePaired},
+		{TransitionUnpair, devicePaired, deviceClaimed, testRegistry, "unpairAftermarketDeviceSign(uint256,uint256,bytes)", AftermarketDeviceClaimed},
+		{TransitionReturn, devicePaired, deviceMinted, testAdId, "returnToManufacturer(uint256)", AftermarketDeviceMinted},
+		{TransitionBurn, deviceClaimed, fakeDevice{}, testRegistry, "adminBurnAftermarketDevices(uint256[])", AftermarketDeviceBurned},
+		{TransitionBurnAndUnpair, devicePaired, fakeDevice{}, testRegistry, "adminBurnAftermarketDevicesAndDeletePairings(uint256[])", AftermarketDeviceBurned},
+	} {
+		t.Run(string(tt.transition), func(t *testing.T) {
+			device := tt.from
+			backend := &fakeBackend{call: deviceCalls(t, &device)}
+			// Mining the transaction applies the transition.
+			backend.logs = func(*types.Transaction) []*types.Log {
+				device = tt.to
+				return nil
+			}
+			d, err := newAftermarketDeviceClient(t, backend).Get(&bind.CallOpts{}, big.NewInt(7))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			opts, _ := transactOpts(t)
+			if err := transitions[tt.transition](d, opts); err != nil {
+				t.Fatal(err)
+			}
+			tx := backend.lastSent(t)
+			if *tx.To() != tt.contract || !bytes.Equal(tx.Data()[:4], selector(tt.signature)) {
+				t.Errorf("sent %x to %s, want %s to %s", tx.Data()[:4], tx.To(), tt.signature, tt.contract)
+			}
+			if d.State != tt.want {
+				t.Errorf("state after %s = %s, want %s", tt.transition, d.State, tt.want)
+			}
+		})
+	}
+}
+
+func TestAftermarketDeviceMint(t *testing.T) {
+	manufacturer := big.NewInt(137)
+	infos := []contracts.AftermarketDeviceInfos{{Addr: common.HexToAddress("0xa1")}, {Addr: common.HexToAddress("0xa2")}}
+	mintedLog := func(id int64, address common.Address) *types.Log {
+		return eventLog(t, address, "AftermarketDeviceNodeMinted", manufacturer, big.NewInt(id), common.HexToAddress("0xa1"), common.HexToAddress("0xaa"))
+	}
+
+	backend := &fakeBackend{logs: func(*types.Transaction) []*types.Log {
+		return []*types.Log{mintedLog(11, testRegistry), mintedLog(99, testAdId), mintedLog(12, testRegistry)}
+	}}
+	opts, _ := transactOpts(t)
+	ids, err := newAftermarketDeviceClient(t, backend).Mint(opts, manufacturer, infos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0].Int64() != 11 || ids[1].Int64() != 12 {
+		t.Errorf("Mint() = %v, want [11 12]", ids)
+	}
+	if sel := selector("mintAftermarketDeviceByManufacturerBatch(uint256,(address,(string,string)[])[])"); !bytes.Equal(backend.lastSent(t).Data()[:4], sel) {
+		t.Errorf("Mint() sent %x, want %x", backend.lastSent(t).Data()[:4], sel)
+	}
+
+	backend.logs = nil
+	if _, err := newAftermarketDeviceClient(t, backend).Mint(opts, manufacturer, infos); !errors.Is(err, ErrAftermarketDeviceNotMinted) {
+		t.Errorf("Mint() without logs = %v, want %v", err, ErrAftermarketDeviceNotMinted)
+	}
+}
+
+func TestAftermarketDeviceReprovision(t *testing.T) {
+	device := deviceClaimed
+	backend := &fakeBackend{call: deviceCalls(t, &device)}
+	backend.logs = func(*types.Transaction) []*types.Log {
+		device = fakeDevice{}
+		return []*types.Log{eventLog(t, testRegistry, "AftermarketDeviceNodeMinted", big.NewInt(137), big.NewInt(8), deviceClaimed.address, common.HexToAddress("0xaa"))}
+	}
+	d, err := newAftermarketDeviceClient(t, backend).Get(&bind.CallOpts{}, big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts, _ := transactOpts(t)
+	id, err := d.Reprovision(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Int64() != 8 || d.State != AftermarketDeviceBurned {
+		t.Errorf("Reprovision() = %s with the device %s, want 8 with the device burned", id, d.State)
+	}
+	if sel := selector("reprovisionAftermarketDeviceByManufacturerBatch(uint256[])"); !bytes.Equal(backend.lastSent(t).Data()[:4], sel) {
+		t.Errorf("Reprovision() sent %x, want %x", backend.lastSent(t).Data()[:4], sel)
+	}
+}
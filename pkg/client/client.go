@@ -0,0 +1,53 @@
+// Package client provides hand-written, task oriented wrappers around the
+// generated DIMORegistry bindings.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+// ErrTransactionReverted is returned when a transaction is mined with a
+// failed status.
+var ErrTransactionReverted = errors.New("client: transaction reverted")
+
+// Backend is the chain access the clients need: contract calls and
+// transactions, plus receipts to wait for them to be mined.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// optsContext returns the context of opts, or the background context if it
+// has none.
+func optsContext(opts *bind.TransactOpts) context.Context {
+	if opts.Context == nil {
+		return context.Background()
+	}
+	return opts.Context
+}
+
+// waitMined waits for tx to be mined and returns its receipt, or
+// ErrTransactionReverted if it failed. sendErr is the error returned when
+// sending tx, and is decoded into a registryerrors type if it carries revert
+// data.
+func waitMined(opts *bind.TransactOpts, backend bind.DeployBackend, tx *types.Transaction, sendErr error) (*types.Receipt, error) {
+	if sendErr != nil {
+		return nil, registryerrors.FromError(sendErr)
+	}
+
+	receipt, err := bind.WaitMined(optsContext(opts), backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%w: %s", ErrTransactionReverted, tx.Hash().Hex())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var (
+	testChainID  = big.NewInt(1337)
+	testRegistry = common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c")
+)
+
+// fakeBackend records the transactions sent to it and mines each one at
+// once, with the logs returned by logs. Calls are answered by call.
+type fakeBackend struct {
+	mu   sync.Mutex
+	sent []*types.Transaction
+
+	sendErr error
+	failed  bool
+	logs    func(tx *types.Transaction) []*types.Log
+	call    func(msg ethereum.CallMsg) ([]byte, error)
+}
+
+func (b *fakeBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (b *fakeBackend) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if b.call == nil {
+		return nil, nil
+	}
+	return b.call(msg)
+}
+
+func (b *fakeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1e9)}, nil
+}
+
+func (b *fakeBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (b *fakeBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return uint64(len(b.sent)), nil
+}
+
+func (b *fakeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 100_000, nil
+}
+
+func (b *fakeBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	if b.sendErr != nil {
+		return b.sendErr
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeBackend) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, ethereum.NotFound
+}
+
+func (b *fakeBackend) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, tx := range b.sent {
+		if tx.Hash() != hash {
+			continue
+		}
+		receipt := &types.Receipt{TxHash: hash, Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1)}
+		if b.failed {
+			receipt.Status = types.ReceiptStatusFailed
+		} else if b.logs != nil {
+			receipt.Logs = b.logs(tx)
+		}
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// rpcDataError is an RPC error carrying revert data, as returned by
+// eth_estimateGas.
+type rpcDataError struct {
+	data interface{}
+}
+
+func (e *rpcDataError) Error() string          { return "execution reverted" }
+func (e *rpcDataError) ErrorData() interface{} { return e.data }
+
+// lastSent returns the last transaction sent.
+func (b *fakeBackend) lastSent(t *testing.T) *types.Transaction {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.sent) == 0 {
+		t.Fatal("no transaction sent")
+	}
+	return b.sent[len(b.sent)-1]
+}
+
+func transactOpts(t *testing.T) (*bind.TransactOpts, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.Context = context.Background()
+	return opts, key
+}
+
+// selector returns the function selector of a Solidity signature.
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
+
+// eventLog returns a log of the registry event name emitted by address.
+func eventLog(t *testing.T, address common.Address, name string, args ...interface{}) *types.Log {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, ok := parsed.Events[name]
+	if !ok {
+		t.Fatalf("no event %s in the registry ABI", name)
+	}
+	log := &types.Log{Address: address, Topics: []common.Hash{ev.ID}}
+	var data []interface{}
+	var nonIndexed abi.Arguments
+	for i, in := range ev.Inputs {
+		if !in.Indexed {
+			data = append(data, args[i])
+			nonIndexed = append(nonIndexed, in)
+			continue
+		}
+		topics, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		log.Topics = append(log.Topics, topics[0][0])
+	}
+	if log.Data, err = nonIndexed.Pack(data...); err != nil {
+		t.Fatal(err)
+	}
+	return log
+}
@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// ErrVehicleNotMinted is returned when a mint receipt has no
+// VehicleNodeMintedWithDeviceDefinition log.
+var ErrVehicleNotMinted = errors.New("client: no VehicleNodeMintedWithDeviceDefinition log in receipt")
+
+// MintVehicleInput holds the arguments shared by every vehicle mint.
+type MintVehicleInput struct {
+	ManufacturerNode   *big.Int
+	Owner              common.Address
+	DeviceDefinitionId string
+	AttrInfo           []contracts.AttributeInfoPair
+}
+
+// DeviceDefinitionChecker verifies that device definition ids exist before
+// they are written to the registry, which accepts any id.
+// *devicedefinition.Checker satisfies it.
+type DeviceDefinitionChecker interface {
+	Check(ctx context.Context, manufacturerId *big.Int, id string) error
+	CheckVehicles(ctx context.Context, vehicleIdProxy common.Address, vehicleIdDdId []contracts.DevAdminVehicleIdDeviceDefinitionId) error
+}
+
+// VehicleClient mints and manages vehicle nodes through the DIMORegistry. Every
+// method waits for its transaction to be mined.
+type VehicleClient struct {
+	address  common.Address
+	registry *contracts.Registry
+	backend  Backend
+
+	checker        DeviceDefinitionChecker
+	vehicleIdProxy common.Address
+}
+
+// NewVehicleClient returns a VehicleClient for the registry deployed at address.
+func NewVehicleClient(address common.Address, backend Backend) (*VehicleClient, error) {
+	registry, err := contracts.NewRegistry(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleClient{address: address, registry: registry, backend: backend}, nil
+}
+
+// SetChecker makes the mint methods and SetDeviceDefinition check the device
+// definition id with checker first, and return its error without sending
+// anything if the check fails. vehicleIdProxy is the VehicleId NFT address,
+// from which SetDeviceDefinition finds the manufacturer of the vehicle. A nil
+// checker turns the checks off.
+func (c *VehicleClient) SetChecker(checker DeviceDefinitionChecker, vehicleIdProxy common.Address) {
+	c.checker = checker
+	c.vehicleIdProxy = vehicleIdProxy
+}
+
+// Mint mints a vehicle with the registry's default storage node. The
+// registry marks this overload as deprecated in favor of
+// MintWithStorageNode.
+//
+// Solidity: mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[])
+func (c *VehicleClient) Mint(opts *bind.TransactOpts, in MintVehicleInput) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinition2(opts, in.ManufacturerNode, in.Owner, in.DeviceDefinitionId, in.AttrInfo)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// MintWithStorageNode mints a vehicle linked to storageNodeId.
+//
+// Solidity: mintVehicleWithDeviceDefinition(uint256,address,uint256,string,(string,string)[])
+func (c *VehicleClient) MintWithStorageNode(opts *bind.TransactOpts, in MintVehicleInput, storageNodeId *big.Int) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinition(opts, in.ManufacturerNode, in.Owner, storageNodeId, in.DeviceDefinitionId, in.AttrInfo)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// MintWithSacd mints a vehicle with the registry's default storage node and
+// grants the SACD permissions in sacd. The registry marks this overload as
+// deprecated in favor of MintWithStorageNodeAndSacd.
+//
+// Solidity: mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[],(address,uint256,uint256,string))
+func (c *VehicleClient) MintWithSacd(opts *bind.TransactOpts, in MintVehicleInput, sacd contracts.SacdInput) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinition1(opts, in.ManufacturerNode, in.Owner, in.DeviceDefinitionId, in.AttrInfo, sacd)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// MintWithStorageNodeAndSacd mints a vehicle linked to storageNodeId and
+// grants the SACD permissions in sacd.
+//
+// Solidity: mintVehicleWithDeviceDefinition(uint256,address,uint256,string,(string,string)[],(address,uint256,uint256,string))
+func (c *VehicleClient) MintWithStorageNodeAndSacd(opts *bind.TransactOpts, in MintVehicleInput, storageNodeId *big.Int, sacd contracts.SacdInput) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinition0(opts, in.ManufacturerNode, in.Owner, storageNodeId, in.DeviceDefinitionId, in.AttrInfo, sacd)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// MintSigned mints a vehicle on behalf of in.Owner, who signed a
+// MintVehicleWithDeviceDefinitionSign message (see eip712). The registry
+// marks this overload as deprecated in favor of MintSignedWithStorageNode.
+//
+// Solidity: mintVehicleWithDeviceDefinitionSign(uint256,address,string,(string,string)[],bytes)
+func (c *VehicleClient) MintSigned(opts *bind.TransactOpts, in MintVehicleInput, signature []byte) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinitionSign0(opts, in.ManufacturerNode, in.Owner, in.DeviceDefinitionId, in.AttrInfo, signature)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// MintSignedWithStorageNode mints a vehicle linked to storageNodeId on behalf
+// of in.Owner, who signed a MintVehicleWithDeviceDefinitionSign message.
+//
+// Solidity: mintVehicleWithDeviceDefinitionSign(uint256,address,uint256,string,(string,string)[],bytes)
+func (c *VehicleClient) MintSignedWithStorageNode(opts *bind.TransactOpts, in MintVehicleInput, storageNodeId *big.Int, signature []byte) (*big.Int, error) {
+	if err := c.checkMint(opts, in); err != nil {
+		return nil, err
+	}
+	tx, err := c.registry.MintVehicleWithDeviceDefinitionSign(opts, in.ManufacturerNode, in.Owner, storageNodeId, in.DeviceDefinitionId, in.AttrInfo, signature)
+	return c.mintedVehicleId(opts, tx, err)
+}
+
+// Burn burns an unpaired vehicle on behalf of its owner, who signed a
+// BurnVehicleSign message.
+//
+// Solidity: burnVehicleSign(uint256,bytes)
+func (c *VehicleClient) Burn(opts *bind.TransactOpts, vehicleId *big.Int, ownerSig []byte) (*types.Receipt, error) {
+	tx, err := c.registry.BurnVehicleSign(opts, vehicleId, ownerSig)
+	return waitMined(opts, c.backend, tx, err)
+}
+
+// SetInfo sets whitelisted attributes of a vehicle.
+//
+// Solidity: setVehicleInfo(uint256,(string,string)[])
+func (c *VehicleClient) SetInfo(opts *bind.TransactOpts, vehicleId *big.Int, attrInfo []contracts.AttributeInfoPair) (*types.Receipt, error) {
+	tx, err := c.registry.SetVehicleInfo(opts, vehicleId, attrInfo)
+	return waitMined(opts, c.backend, tx, err)
+}
+
+// SetDeviceDefinition changes the device definition id of a vehicle. The
+// sender must be allowed to call the DevAdmin module.
+//
+// Solidity: adminSetVehicleDDs((uint256,string)[])
+func (c *VehicleClient) SetDeviceDefinition(opts *bind.TransactOpts, vehicleId *big.Int, deviceDefinitionId string) (*types.Receipt, error) {
+	vehicleIdDdId := []contracts.DevAdminVehicleIdDeviceDefinitionId{
+		{VehicleId: vehicleId, DeviceDefinitionId: deviceDefinitionId},
+	}
+	if c.checker != nil {
+		if err := c.checker.CheckVehicles(optsContext(opts), c.vehicleIdProxy, vehicleIdDdId); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := c.registry.AdminSetVehicleDDs(opts, vehicleIdDdId)
+	return waitMined(opts, c.backend, tx, err)
+}
+
+// DeviceDefinition returns the device definition id of a vehicle.
+func (c *VehicleClient) DeviceDefinition(opts *bind.CallOpts, vehicleId *big.Int) (string, error) {
+	return c.registry.GetDeviceDefinitionIdByVehicleId(opts, vehicleId)
+}
+
+// checkMint checks the device definition id of a mint, see SetChecker.
+func (c *VehicleClient) checkMint(opts *bind.TransactOpts, in MintVehicleInput) error {
+	if c.checker == nil {
+		return nil
+	}
+	return c.checker.Check(optsContext(opts), in.ManufacturerNode, in.DeviceDefinitionId)
+}
+
+// mintedVehicleId waits for a mint transaction and returns the vehicle id of
+// its VehicleNodeMintedWithDeviceDefinition log.
+func (c *VehicleClient) mintedVehicleId(opts *bind.TransactOpts, tx *types.Transaction, sendErr error) (*big.Int, error) {
+	receipt, err := waitMined(opts, c.backend, tx, sendErr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != c.address {
+			continue
+		}
+		ev, err := c.registry.ParseVehicleNodeMintedWithDeviceDefinition(*log)
+		if err != nil {
+			continue
+		}
+		return ev.VehicleId, nil
+	}
+	return nil, ErrVehicleNotMinted
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/devicedefinition"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+var _ DeviceDefinitionChecker = (*devicedefinition.Checker)(nil)
+
+var testMintInput = MintVehicleInput{
+	ManufacturerNode:   big.NewInt(137),
+	Owner:              common.HexToAddress("0x1"),
+	DeviceDefinitionId: "ford_bronco_2022",
+	AttrInfo:           []contracts.AttributeInfoPair{{Attribute: "Make", Info: "Ford"}},
+}
+
+// mintedLogs returns a receipt log minting vehicle id with testMintInput.
+func mintedLogs(t *testing.T, id int64) func(*types.Transaction) []*types.Log {
+	return func(*types.Transaction) []*types.Log {
+		return []*types.Log{eventLog(t, testRegistry, "VehicleNodeMintedWithDeviceDefinition",
+			testMintInput.ManufacturerNode, big.NewInt(id), testMintInput.Owner, testMintInput.DeviceDefinitionId)}
+	}
+}
+
+// TestVehicleClientMint checks that every mint method sends the overload
+// named in its doc comment and returns the minted vehicle id.
+func TestVehicleClientMint(t *testing.T) {
+	storageNode := big.NewInt(2)
+	sacd := contracts.SacdInput{Grantee: common.HexToAddress("0x2"), Permissions: big.NewInt(3), Expiration: big.NewInt(4), Source: "ipfs://"}
+	sig := []byte("signature")
+
+	tests := []struct {
+		signature string
+		mint      func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error)
+	}{
+		{
+			"mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[])",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.Mint(opts, testMintInput)
+			},
+		},
+		{
+			"mintVehicleWithDeviceDefinition(uint256,address,uint256,string,(string,string)[])",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.MintWithStorageNode(opts, testMintInput, storageNode)
+			},
+		},
+		{
+			"mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[],(address,uint256,uint256,string))",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.MintWithSacd(opts, testMintInput, sacd)
+			},
+		},
+		{
+			"mintVehicleWithDeviceDefinition(uint256,address,uint256,string,(string,string)[],(address,uint256,uint256,string))",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.MintWithStorageNodeAndSacd(opts, testMintInput, storageNode, sacd)
+			},
+		},
+		{
+			"mintVehicleWithDeviceDefinitionSign(uint256,address,string,(string,string)[],bytes)",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.MintSigned(opts, testMintInput, sig)
+			},
+		},
+		{
+			"mintVehicleWithDeviceDefinitionSign(uint256,address,uint256,string,(string,string)[],bytes)",
+			func(c *VehicleClient, opts *bind.TransactOpts) (*big.Int, error) {
+				return c.MintSignedWithStorageNode(opts, testMintInput, storageNode, sig)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.signature, func(t *testing.T) {
+			backend := &fakeBackend{logs: mintedLogs(t, 42)}
+			c, err := NewVehicleClient(testRegistry, backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts, _ := transactOpts(t)
+
+			id, err := tt.mint(c, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Cmp(big.NewInt(42)) != 0 {
+				t.Errorf("vehicle id = %s, want 42", id)
+			}
+			tx := backend.lastSent(t)
+			if *tx.To() != testRegistry {
+				t.Errorf("sent to %s, want %s", tx.To(), testRegistry)
+			}
+			if got, want := tx.Data()[:4], selector(tt.signature); !bytes.Equal(got, want) {
+				t.Errorf("selector = %x, want %x", got, want)
+			}
+		})
+	}
+}
+
+func TestVehicleClientMintErrors(t *testing.T) {
+	opts, _ := transactOpts(t)
+	other := common.HexToAddress("0xdead")
+	revert := &rpcDataError{data: append(selector("InvalidParentNode(uint256)"), common.LeftPadBytes([]byte{137}, 32)...)}
+
+	tests := []struct {
+		name    string
+		backend *fakeBackend
+		want    error
+	}{
+		{"no log", &fakeBackend{}, ErrVehicleNotMinted},
+		{"log of another contract", &fakeBackend{logs: func(*types.Transaction) []*types.Log {
+			return []*types.Log{eventLog(t, other, "VehicleNodeMintedWithDeviceDefinition",
+				testMintInput.ManufacturerNode, big.NewInt(42), testMintInput.Owner, testMintInput.DeviceDefinitionId)}
+		}}, ErrVehicleNotMinted},
+		{"reverted", &fakeBackend{failed: true}, ErrTransactionReverted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewVehicleClient(testRegistry, tt.backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.Mint(opts, testMintInput); !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("revert data", func(t *testing.T) {
+		c, err := NewVehicleClient(testRegistry, &fakeBackend{sendErr: revert})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.Mint(opts, testMintInput)
+		var invalid *registryerrors.InvalidParentNode
+		if !errors.As(err, &invalid) || invalid.TokenID.Int64() != 137 {
+			t.Fatalf("error = %v, want InvalidParentNode(137)", err)
+		}
+	})
+}
+
+func TestVehicleClientSetDeviceDefinition(t *testing.T) {
+	backend := &fakeBackend{}
+	c, err := NewVehicleClient(testRegistry, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, _ := transactOpts(t)
+	if _, err := c.SetDeviceDefinition(opts, big.NewInt(42), "ford_bronco_2023"); err != nil {
+		t.Fatal(err)
+	}
+	data := backend.lastSent(t).Data()
+	if got, want := data[:4], selector("adminSetVehicleDDs((uint256,string)[])"); !bytes.Equal(got, want) {
+		t.Errorf("selector = %x, want %x", got, want)
+	}
+}
+
+// checkedBackend returns a fakeBackend answering the reads of a
+// devicedefinition.Checker: testMintInput.ManufacturerNode has the table
+// "_137_42" and every vehicle belongs to it.
+func checkedBackend(t *testing.T) *fakeBackend {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeBackend{
+		logs: mintedLogs(t, 42),
+		call: func(msg ethereum.CallMsg) ([]byte, error) {
+			method, err := parsed.MethodById(msg.Data)
+			if err != nil {
+				return nil, err
+			}
+			switch method.Name {
+			case "getDeviceDefinitionTableName":
+				return method.Outputs.Pack("_137_42")
+			case "getParentNode":
+				return method.Outputs.Pack(testMintInput.ManufacturerNode)
+			}
+			return nil, errors.New("unexpected call to " + method.Name)
+		},
+	}
+}
+
+func TestVehicleClientChecker(t *testing.T) {
+	tables := devicedefinition.NewMemoryTableland()
+	tables.Put("_137_42", contracts.DeviceDefinitionInput{Id: testMintInput.DeviceDefinitionId, Model: "Bronco", Year: big.NewInt(2022)})
+	unknown := testMintInput
+	unknown.DeviceDefinitionId = "ford_bronco_2099"
+
+	tests := []struct {
+		name string
+		call func(c *VehicleClient, opts *bind.TransactOpts) error
+		want error
+	}{
+		{"mint", func(c *VehicleClient, opts *bind.TransactOpts) error {
+			_, err := c.Mint(opts, testMintInput)
+			return err
+		}, nil},
+		{"mint unknown", func(c *VehicleClient, opts *bind.TransactOpts) error {
+			_, err := c.Mint(opts, unknown)
+			return err
+		}, devicedefinition.ErrRowNotFound},
+		{"mint signed unknown", func(c *VehicleClient, opts *bind.TransactOpts) error {
+			_, err := c.MintSignedWithStorageNode(opts, unknown, big.NewInt(2), []byte("signature"))
+			return err
+		}, devicedefinition.ErrRowNotFound},
+		{"set device definition", func(c *VehicleClient, opts *bind.TransactOpts) error {
+			_, err := c.SetDeviceDefinition(opts, big.NewInt(42), testMintInput.DeviceDefinitionId)
+			return err
+		}, nil},
+		{"set unknown device definition", func(c *VehicleClient, opts *bind.TransactOpts) error {
+			_, err := c.SetDeviceDefinition(opts, big.NewInt(42), unknown.DeviceDefinitionId)
+			return err
+		}, devicedefinition.ErrRowNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := checkedBackend(t)
+			c, err := NewVehicleClient(testRegistry, backend)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checker, err := devicedefinition.NewChecker(testRegistry, backend, tables)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.SetChecker(checker, common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF"))
+			opts, _ := transactOpts(t)
+
+			err = tt.call(c, opts)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+			if sent := len(backend.sent); (tt.want == nil) != (sent == 1) {
+				t.Errorf("%d transactions sent", sent)
+			}
+		})
+	}
+
+	t.Run("no checker", func(t *testing.T) {
+		backend := checkedBackend(t)
+		c, err := NewVehicleClient(testRegistry, backend)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetChecker(nil, common.Address{})
+		opts, _ := transactOpts(t)
+		if _, err := c.Mint(opts, unknown); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
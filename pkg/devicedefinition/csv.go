@@ -0,0 +1,71 @@
+package devicedefinition
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// ReadCSV reads device definitions in the format of
+// scripts/data/ddInsertInput.csv: a header row naming the columns id, model,
+// year, metadata, ksuid, deviceType and imageURI in any order, then one row
+// per device definition. The id, model and year columns are required. Values
+// are read as they should be stored, unescaped.
+func ReadCSV(r io.Reader) ([]contracts.DeviceDefinitionInput, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"id", "model", "year"} {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("csv: missing %s column", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := index[name]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var out []contracts.DeviceDefinitionInput
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+
+		yearField := strings.TrimSpace(field(record, "year"))
+		year, ok := new(big.Int).SetString(yearField, 10)
+		if !ok {
+			return nil, fmt.Errorf("csv: line %d: invalid year %q", line, yearField)
+		}
+		out = append(out, contracts.DeviceDefinitionInput{
+			Id:         field(record, "id"),
+			Model:      field(record, "model"),
+			Year:       year,
+			Metadata:   field(record, "metadata"),
+			Ksuid:      field(record, "ksuid"),
+			DeviceType: field(record, "deviceType"),
+			ImageURI:   field(record, "imageURI"),
+		})
+	}
+}
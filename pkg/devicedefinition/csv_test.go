@@ -0,0 +1,79 @@
+package devicedefinition
+
+import (
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		want    []contracts.DeviceDefinitionInput
+		wantErr string
+	}{
+		{"empty", "", nil, ""},
+		{"header only", "id,model,year\n", nil, ""},
+		{
+			"all columns",
+			"id,model,year,metadata,ksuid,deviceType,imageURI\n" +
+				`ford_bronco_2022,Bronco,2022,"{""a"": 1}",26G3iFH7Xc9Wvsw7pg6sD7uzoSS,vehicle,https://image.com/image.png` + "\n",
+			[]contracts.DeviceDefinitionInput{{
+				Id: "ford_bronco_2022", Model: "Bronco", Year: big.NewInt(2022), Metadata: `{"a": 1}`,
+				Ksuid: "26G3iFH7Xc9Wvsw7pg6sD7uzoSS", DeviceType: "vehicle", ImageURI: "https://image.com/image.png",
+			}},
+			"",
+		},
+		{
+			"columns in any order",
+			"year, model ,id\n 1984 ,Land Cruiser '70,toyota_land_cruiser_'70\n2022,Bronco,ford_bronco_2022\n",
+			[]contracts.DeviceDefinitionInput{
+				{Id: "toyota_land_cruiser_'70", Model: "Land Cruiser '70", Year: big.NewInt(1984)},
+				{Id: "ford_bronco_2022", Model: "Bronco", Year: big.NewInt(2022)},
+			},
+			"",
+		},
+		{"missing year column", "id,model\na,b\n", nil, "csv: missing year column"},
+		{"invalid year", "id,model,year\na,b,c\nd,e,1999x\n", nil, `csv: line 2: invalid year "c"`},
+		{"wrong field count", "id,model,year\na,b\n", nil, "wrong number of fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tt.csv))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadCSV = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestReadCSVScripts reads the file used by scripts/tableland.
+func TestReadCSVScripts(t *testing.T) {
+	f, err := os.Open("../../scripts/data/ddInsertInput.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	got, err := ReadCSV(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []contracts.DeviceDefinitionInput{{Id: "ddId", Model: "model", Year: big.NewInt(2024), Metadata: "{}", DeviceType: "vehicle"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadCSV = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,161 @@
+package devicedefinition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// UnknownDeviceDefinitionError is returned by a Checker for a device
+// definition id that is not in the manufacturer's table. The registry accepts
+// such ids, so a vehicle minted with one points at nothing.
+type UnknownDeviceDefinitionError struct {
+	ManufacturerId *big.Int
+	Table          string
+	Id             string
+	// VehicleId is set by CheckVehicles.
+	VehicleId *big.Int
+	// Index is the position of the failing element for CheckVehicles and
+	// CheckVehicleAndSdInputs, -1 for Check.
+	Index int
+}
+
+func (e *UnknownDeviceDefinitionError) Error() string {
+	msg := fmt.Sprintf("devicedefinition: device definition %q not found in table %s of manufacturer %s", e.Id, e.Table, e.ManufacturerId)
+	switch {
+	case e.VehicleId != nil:
+		msg += fmt.Sprintf(" for vehicle %s", e.VehicleId)
+	case e.Index >= 0:
+		msg += fmt.Sprintf(" for input %d", e.Index)
+	}
+	return msg
+}
+
+// Unwrap returns ErrRowNotFound.
+func (e *UnknownDeviceDefinitionError) Unwrap() error {
+	return ErrRowNotFound
+}
+
+// Checker verifies that device definition ids exist before they are written
+// to the registry by MintVehicleWithDeviceDefinition*,
+// MintVehicleAndSdWithDeviceDefinitionSign* or AdminSetVehicleDDs. Set on a
+// client.VehicleClient with SetChecker, it guards the client's mints and
+// SetDeviceDefinition; set as the Checker of an sdkeys.Config, it guards
+// MintVehicleAndSdWithDdInput.
+type Checker struct {
+	registry *contracts.RegistryCaller
+	tables   Tableland
+}
+
+// NewChecker returns a Checker resolving tables on the registry deployed at
+// address and looking the ids up in tables.
+func NewChecker(address common.Address, caller bind.ContractCaller, tables Tableland) (*Checker, error) {
+	registry, err := contracts.NewRegistryCaller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+	return &Checker{registry: registry, tables: tables}, nil
+}
+
+// Check returns nil if id is in the table of manufacturerId, e.g. for the
+// ManufacturerNode and DeviceDefinitionId of a client.MintVehicleInput. It
+// returns an error wrapping ErrNoTable if the manufacturer has no table, and
+// an *UnknownDeviceDefinitionError if id is not in it.
+func (c *Checker) Check(ctx context.Context, manufacturerId *big.Int, id string) error {
+	table, err := c.table(ctx, manufacturerId)
+	if err != nil {
+		return err
+	}
+	return c.check(ctx, manufacturerId, table, id)
+}
+
+// CheckVehicles checks the device definition ids of AdminSetVehicleDDs
+// against the tables of the vehicles' manufacturers. vehicleIdProxy is the
+// VehicleId NFT address. It returns the error of the first vehicle that
+// fails, with the VehicleId and Index of an *UnknownDeviceDefinitionError
+// set.
+func (c *Checker) CheckVehicles(ctx context.Context, vehicleIdProxy common.Address, vehicleIdDdId []contracts.DevAdminVehicleIdDeviceDefinitionId) error {
+	opts := &bind.CallOpts{Context: ctx}
+	tables := make(map[string]string)
+	for i, v := range vehicleIdDdId {
+		manufacturerId, err := c.registry.GetParentNode(opts, vehicleIdProxy, v.VehicleId)
+		if err != nil {
+			return fmt.Errorf("vehicle %s: failed to get manufacturer: %w", v.VehicleId, err)
+		}
+		err = c.checkCached(ctx, tables, manufacturerId, v.DeviceDefinitionId)
+		var unknown *UnknownDeviceDefinitionError
+		if errors.As(err, &unknown) {
+			unknown.VehicleId, unknown.Index = v.VehicleId, i
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("vehicle %s: %w", v.VehicleId, err)
+		}
+	}
+	return nil
+}
+
+// CheckVehicleAndSdInputs checks the device definition ids of
+// MintVehicleAndSdWithDeviceDefinitionSign and its batch variant, e.g. built
+// by sdkeys.Deriver.MintVehicleAndSdWithDdInput, against the tables of their
+// ManufacturerNode. It returns the error of the first input that fails, with
+// the Index of an *UnknownDeviceDefinitionError set.
+func (c *Checker) CheckVehicleAndSdInputs(ctx context.Context, ins []contracts.MintVehicleAndSdWithDdInput) error {
+	tables := make(map[string]string)
+	for i, in := range ins {
+		err := c.checkCached(ctx, tables, in.ManufacturerNode, in.DeviceDefinitionId)
+		var unknown *UnknownDeviceDefinitionError
+		if errors.As(err, &unknown) {
+			unknown.Index = i
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// checkCached checks id against the table of manufacturerId, looking the
+// table up once per manufacturer in tables.
+func (c *Checker) checkCached(ctx context.Context, tables map[string]string, manufacturerId *big.Int, id string) error {
+	table, ok := tables[manufacturerId.String()]
+	if !ok {
+		var err error
+		if table, err = c.table(ctx, manufacturerId); err != nil {
+			return err
+		}
+		tables[manufacturerId.String()] = table
+	}
+	return c.check(ctx, manufacturerId, table, id)
+}
+
+// table returns the name of the table of manufacturerId, as
+// GetDeviceDefinitionTableName, e.g. "_137_42".
+func (c *Checker) table(ctx context.Context, manufacturerId *big.Int) (string, error) {
+	table, err := c.registry.GetDeviceDefinitionTableName(&bind.CallOpts{Context: ctx}, manufacturerId)
+	if err != nil {
+		return "", err
+	}
+	if table == "" {
+		return "", fmt.Errorf("%w: %s", ErrNoTable, manufacturerId)
+	}
+	return table, nil
+}
+
+func (c *Checker) check(ctx context.Context, manufacturerId *big.Int, table, id string) error {
+	_, err := c.tables.Row(ctx, table, id)
+	if errors.Is(err, ErrRowNotFound) {
+		return &UnknownDeviceDefinitionError{ManufacturerId: manufacturerId, Table: table, Id: id, Index: -1}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up device definition %q in %s: %w", id, table, err)
+	}
+	return nil
+}
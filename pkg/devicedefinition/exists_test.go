@@ -0,0 +1,191 @@
+package devicedefinition
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var testVehicleId = common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF")
+
+// tablelands returns a MemoryTableland and a SQLTableland holding rows in
+// testTable.
+func tablelands(t *testing.T, rows ...contracts.DeviceDefinitionInput) map[string]Tableland {
+	t.Helper()
+	memory := NewMemoryTableland()
+	memory.Put(testTable, rows...)
+
+	sqlite := newSQLTableland(t)
+	for _, row := range rows {
+		if err := sqlite.Exec(context.Background(), InsertSQL(testChainID, testTableId, Escape(row))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return map[string]Tableland{"memory": memory, "sql": sqlite}
+}
+
+// newTestChecker returns a Checker over tables for a registry where
+// manufacturer 137 has testTable, 138 no table and 139 a table missing from
+// tables. Vehicle n belongs to the manufacturer in parents.
+func newTestChecker(t *testing.T, tables Tableland) (*Checker, *fakeCaller) {
+	t.Helper()
+	names := map[int64]string{137: testTable, 139: "_137_43"}
+	parents := map[int64]int64{1: 137, 2: 138, 3: 139, 4: 137}
+	caller := &fakeCaller{results: map[string]func([]interface{}) []interface{}{
+		"getDeviceDefinitionTableName": func(args []interface{}) []interface{} {
+			return []interface{}{names[args[0].(*big.Int).Int64()]}
+		},
+		"getParentNode": func(args []interface{}) []interface{} {
+			if args[0].(common.Address) != testVehicleId {
+				t.Errorf("parent read from %s, want %s", args[0], testVehicleId)
+			}
+			return []interface{}{big.NewInt(parents[args[1].(*big.Int).Int64()])}
+		},
+	}}
+	c, err := NewChecker(testRegistry, caller, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, caller
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		manufacturerId int64
+		id             string
+		want           error
+	}{
+		{"found", 137, bronco.Id, nil},
+		{"found with a quote", 137, cruiser.Id, nil},
+		{"missing id", 137, "ford_bronco_2023", ErrRowNotFound},
+		{"missing id with a quote", 137, "o'brien", ErrRowNotFound},
+		{"quote breaking out of the literal", 137, "x' OR '1'='1", ErrRowNotFound},
+		{"manufacturer without a table", 138, bronco.Id, ErrNoTable},
+	}
+	for name, tables := range tablelands(t, bronco, cruiser) {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				c, _ := newTestChecker(t, tables)
+				err := c.Check(context.Background(), big.NewInt(tt.manufacturerId), tt.id)
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("error = %v, want %v", err, tt.want)
+				}
+				if tt.want != ErrRowNotFound {
+					return
+				}
+				var unknown *UnknownDeviceDefinitionError
+				if !errors.As(err, &unknown) {
+					t.Fatalf("error = %v, want an *UnknownDeviceDefinitionError", err)
+				}
+				if unknown.Id != tt.id || unknown.Table != testTable || unknown.ManufacturerId.Int64() != tt.manufacturerId || unknown.VehicleId != nil || unknown.Index != -1 {
+					t.Errorf("error = %+v", unknown)
+				}
+			})
+		}
+
+		t.Run(name+"/table missing from Tableland", func(t *testing.T) {
+			c, _ := newTestChecker(t, tables)
+			err := c.Check(context.Background(), big.NewInt(139), bronco.Id)
+			var unknown *UnknownDeviceDefinitionError
+			if err == nil || errors.As(err, &unknown) || errors.Is(err, ErrNoTable) {
+				t.Fatalf("error = %v, want a lookup error", err)
+			}
+		})
+	}
+}
+
+func TestCheckVehicles(t *testing.T) {
+	vehicles := func(pairs ...interface{}) []contracts.DevAdminVehicleIdDeviceDefinitionId {
+		var out []contracts.DevAdminVehicleIdDeviceDefinitionId
+		for i := 0; i < len(pairs); i += 2 {
+			out = append(out, contracts.DevAdminVehicleIdDeviceDefinitionId{
+				VehicleId:          big.NewInt(int64(pairs[i].(int))),
+				DeviceDefinitionId: pairs[i+1].(string),
+			})
+		}
+		return out
+	}
+
+	for name, tables := range tablelands(t, bronco, cruiser) {
+		t.Run(name+"/found", func(t *testing.T) {
+			c, caller := newTestChecker(t, tables)
+			if err := c.CheckVehicles(context.Background(), testVehicleId, vehicles(1, bronco.Id, 4, cruiser.Id)); err != nil {
+				t.Fatal(err)
+			}
+			if n := caller.calls["getDeviceDefinitionTableName"]; n != 1 {
+				t.Errorf("table name read %d times for one manufacturer, want 1", n)
+			}
+		})
+
+		t.Run(name+"/missing id", func(t *testing.T) {
+			c, _ := newTestChecker(t, tables)
+			err := c.CheckVehicles(context.Background(), testVehicleId, vehicles(1, bronco.Id, 4, "toyota_land_cruiser_'71"))
+			var unknown *UnknownDeviceDefinitionError
+			if !errors.As(err, &unknown) {
+				t.Fatalf("error = %v, want an *UnknownDeviceDefinitionError", err)
+			}
+			if unknown.VehicleId.Int64() != 4 || unknown.Index != 1 || unknown.Id != "toyota_land_cruiser_'71" || unknown.ManufacturerId.Int64() != 137 {
+				t.Errorf("error = %+v", unknown)
+			}
+		})
+
+		t.Run(name+"/manufacturer without a table", func(t *testing.T) {
+			c, _ := newTestChecker(t, tables)
+			err := c.CheckVehicles(context.Background(), testVehicleId, vehicles(1, bronco.Id, 2, bronco.Id))
+			if !errors.Is(err, ErrNoTable) {
+				t.Fatalf("error = %v, want %v", err, ErrNoTable)
+			}
+		})
+	}
+}
+
+func TestCheckVehicleAndSdInputs(t *testing.T) {
+	inputs := func(pairs ...interface{}) []contracts.MintVehicleAndSdWithDdInput {
+		var out []contracts.MintVehicleAndSdWithDdInput
+		for i := 0; i < len(pairs); i += 2 {
+			out = append(out, contracts.MintVehicleAndSdWithDdInput{
+				ManufacturerNode:   big.NewInt(int64(pairs[i].(int))),
+				DeviceDefinitionId: pairs[i+1].(string),
+			})
+		}
+		return out
+	}
+
+	for name, tables := range tablelands(t, bronco, cruiser) {
+		t.Run(name+"/found", func(t *testing.T) {
+			c, caller := newTestChecker(t, tables)
+			if err := c.CheckVehicleAndSdInputs(context.Background(), inputs(137, bronco.Id, 137, cruiser.Id)); err != nil {
+				t.Fatal(err)
+			}
+			if n := caller.calls["getDeviceDefinitionTableName"]; n != 1 {
+				t.Errorf("table name read %d times for one manufacturer, want 1", n)
+			}
+		})
+
+		t.Run(name+"/missing id", func(t *testing.T) {
+			c, _ := newTestChecker(t, tables)
+			err := c.CheckVehicleAndSdInputs(context.Background(), inputs(137, bronco.Id, 137, cruiser.Id, 137, "ford_bronco_2023"))
+			var unknown *UnknownDeviceDefinitionError
+			if !errors.As(err, &unknown) {
+				t.Fatalf("error = %v, want an *UnknownDeviceDefinitionError", err)
+			}
+			if unknown.Index != 2 || unknown.VehicleId != nil || unknown.Id != "ford_bronco_2023" || unknown.ManufacturerId.Int64() != 137 {
+				t.Errorf("error = %+v", unknown)
+			}
+		})
+
+		t.Run(name+"/manufacturer without a table", func(t *testing.T) {
+			c, _ := newTestChecker(t, tables)
+			err := c.CheckVehicleAndSdInputs(context.Background(), inputs(137, bronco.Id, 138, bronco.Id))
+			if !errors.Is(err, ErrNoTable) {
+				t.Fatalf("error = %v, want %v", err, ErrNoTable)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package devicedefinition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/dryrun"
+)
+
+// ErrNoTable is returned for a manufacturer without a device definition
+// table, for which every write reverts with TableDoesNotExist.
+var ErrNoTable = errors.New("devicedefinition: manufacturer has no device definition table")
+
+// tablelandABI is the RunSQL event TablelandTables emits for every statement
+// it accepts.
+const tablelandABI = `[{"type":"event","name":"RunSQL","anonymous":false,"inputs":[
+	{"name":"caller","type":"address","indexed":false},
+	{"name":"isOwner","type":"bool","indexed":false},
+	{"name":"tableId","type":"uint256","indexed":false},
+	{"name":"statement","type":"string","indexed":false},
+	{"name":"policy","type":"tuple","indexed":false,"components":[
+		{"name":"allowInsert","type":"bool"},
+		{"name":"allowUpdate","type":"bool"},
+		{"name":"allowDelete","type":"bool"},
+		{"name":"whereClause","type":"string"},
+		{"name":"withCheck","type":"string"},
+		{"name":"updatableColumns","type":"string[]"}]}]}]`
+
+var runSQLEvent = func() abi.Event {
+	parsed, err := abi.JSON(strings.NewReader(tablelandABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events["RunSQL"]
+}()
+
+// Preview is the SQL a device definition write would run.
+type Preview struct {
+	Method  string
+	TableId *big.Int
+	// SQL is the statement rebuilt locally from the input.
+	SQL string
+	// Simulation is the dry run of the write. Its Err is set if the write
+	// would revert.
+	Simulation *dryrun.Result
+	// Executed are the statements of the RunSQL logs of the simulation, nil
+	// if the node could only run it with eth_call.
+	Executed []string
+}
+
+// Matches reports whether the simulated write ran exactly SQL. It is false
+// when the simulation reverted or returned no logs.
+func (p *Preview) Matches() bool {
+	return len(p.Executed) == 1 && p.Executed[0] == p.SQL
+}
+
+// Previewer rebuilds the SQL of device definition writes and runs them
+// through the dry-run simulator, so that the statement can be reviewed
+// before the transaction is signed.
+type Previewer struct {
+	chainID  *big.Int
+	registry *contracts.RegistryCaller
+	sim      *dryrun.Simulator
+}
+
+// NewPreviewer returns a Previewer for the registry deployed at address on
+// chainID. client runs the simulations and caller reads the table ids.
+func NewPreviewer(client dryrun.RPC, caller bind.ContractCaller, address common.Address, chainID *big.Int) (*Previewer, error) {
+	registry, err := contracts.NewRegistryCaller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+	sim, err := dryrun.New(client, address)
+	if err != nil {
+		return nil, err
+	}
+	return &Previewer{chainID: chainID, registry: registry, sim: sim}, nil
+}
+
+// Insert previews InsertDeviceDefinition sent by from.
+func (p *Previewer) Insert(ctx context.Context, from common.Address, manufacturerId *big.Int, in contracts.DeviceDefinitionInput) (*Preview, error) {
+	return p.preview(ctx, from, manufacturerId, "insertDeviceDefinition", func(tableId *big.Int) string {
+		return InsertSQL(p.chainID, tableId, in)
+	}, manufacturerId, in)
+}
+
+// InsertBatch previews InsertDeviceDefinitionBatch sent by from.
+func (p *Previewer) InsertBatch(ctx context.Context, from common.Address, manufacturerId *big.Int, ins []contracts.DeviceDefinitionInput) (*Preview, error) {
+	return p.preview(ctx, from, manufacturerId, "insertDeviceDefinitionBatch", func(tableId *big.Int) string {
+		return InsertBatchSQL(p.chainID, tableId, ins)
+	}, manufacturerId, ins)
+}
+
+// Update previews UpdateDeviceDefinition sent by from.
+func (p *Previewer) Update(ctx context.Context, from common.Address, manufacturerId *big.Int, in contracts.DeviceDefinitionUpdateInput) (*Preview, error) {
+	return p.preview(ctx, from, manufacturerId, "updateDeviceDefinition", func(tableId *big.Int) string {
+		return UpdateSQL(p.chainID, tableId, in)
+	}, manufacturerId, in)
+}
+
+// Delete previews DeleteDeviceDefinition sent by from.
+func (p *Previewer) Delete(ctx context.Context, from common.Address, manufacturerId *big.Int, id string) (*Preview, error) {
+	return p.preview(ctx, from, manufacturerId, "deleteDeviceDefinition", func(tableId *big.Int) string {
+		return DeleteSQL(p.chainID, tableId, id)
+	}, manufacturerId, id)
+}
+
+func (p *Previewer) preview(ctx context.Context, from common.Address, manufacturerId *big.Int, method string, sql func(tableId *big.Int) string, args ...interface{}) (*Preview, error) {
+	tableId, err := p.registry.GetDeviceDefinitionTableId(&bind.CallOpts{Context: ctx}, manufacturerId)
+	if err != nil {
+		return nil, err
+	}
+	if tableId.Sign() == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoTable, manufacturerId)
+	}
+
+	res, err := p.sim.Simulate(ctx, from, nil, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	preview := &Preview{Method: method, TableId: tableId, SQL: sql(tableId), Simulation: res}
+	if preview.Executed, err = statements(res.Logs); err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// statements returns the statements of the RunSQL logs.
+func statements(logs []types.Log) ([]string, error) {
+	var out []string
+	for _, log := range logs {
+		if len(log.Topics) == 0 || log.Topics[0] != runSQLEvent.ID {
+			continue
+		}
+		values, err := runSQLEvent.Inputs.Unpack(log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode RunSQL log %d: %w", log.Index, err)
+		}
+		out = append(out, values[3].(string))
+	}
+	return out, nil
+}
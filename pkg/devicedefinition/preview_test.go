@@ -0,0 +1,191 @@
+package devicedefinition
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var (
+	testRegistry        = common.HexToAddress("0x5eAA351EdFc8BcE8Fa9dEa2FeDdd5A84cdadE96C")
+	testTablelandTables = common.HexToAddress("0x8C0Bc0D6D7A0bE2ff7b6F93f1e1B0bC1B0cD2E3f")
+	testFrom            = common.HexToAddress("0xa1")
+)
+
+// fakeCaller answers registry view calls with the outputs returned by the
+// function of the method name, and counts the calls.
+type fakeCaller struct {
+	results map[string]func(args []interface{}) []interface{}
+	calls   map[string]int
+}
+
+func (c *fakeCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *fakeCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := c.results[method.Name]
+	if !ok {
+		return nil, errors.New("unexpected call to " + method.Name)
+	}
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if c.calls == nil {
+		c.calls = make(map[string]int)
+	}
+	c.calls[method.Name]++
+	return method.Outputs.Pack(result(args)...)
+}
+
+// fakeRPC answers eth_simulateV1 with a successful call emitting logs.
+type fakeRPC struct {
+	logs []map[string]interface{}
+}
+
+func (r *fakeRPC) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	if method != "eth_simulateV1" {
+		return errors.New("unexpected method " + method)
+	}
+	out, err := json.Marshal([]map[string]interface{}{{"calls": []interface{}{map[string]interface{}{
+		"status": "0x1", "returnData": "0x", "gasUsed": "0x5208", "logs": r.logs,
+	}}}})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(out, result)
+}
+
+// runSQLLog returns a RunSQL log of the Tableland contract for statement.
+func runSQLLog(t *testing.T, statement string) map[string]interface{} {
+	t.Helper()
+	policy := struct {
+		AllowInsert      bool
+		AllowUpdate      bool
+		AllowDelete      bool
+		WhereClause      string
+		WithCheck        string
+		UpdatableColumns []string
+	}{true, true, true, "", "", []string{}}
+	data, err := runSQLEvent.Inputs.Pack(testRegistry, false, testTableId, statement, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]interface{}{
+		"address":          testTablelandTables,
+		"topics":           []common.Hash{runSQLEvent.ID},
+		"data":             hexutil.Bytes(data),
+		"blockNumber":      "0x10",
+		"transactionHash":  common.Hash{1},
+		"transactionIndex": "0x0",
+		"blockHash":        common.Hash{2},
+		"logIndex":         "0x0",
+		"removed":          false,
+	}
+}
+
+func newTestPreviewer(t *testing.T, tableId *big.Int, executed ...string) *Previewer {
+	t.Helper()
+	rpc := &fakeRPC{logs: []map[string]interface{}{}}
+	for _, statement := range executed {
+		rpc.logs = append(rpc.logs, runSQLLog(t, statement))
+	}
+	caller := &fakeCaller{results: map[string]func([]interface{}) []interface{}{
+		"getDeviceDefinitionTableId": func([]interface{}) []interface{} { return []interface{}{tableId} },
+	}}
+	p, err := NewPreviewer(rpc, caller, testRegistry, testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPreview(t *testing.T) {
+	ctx := context.Background()
+	manufacturerId := big.NewInt(137)
+	in := validInput()
+	update := contracts.DeviceDefinitionUpdateInput{Id: in.Id, Metadata: "{}"}
+
+	tests := []struct {
+		method  string
+		sql     string
+		preview func(p *Previewer) (*Preview, error)
+	}{
+		{"insertDeviceDefinition", InsertSQL(testChainID, testTableId, in), func(p *Previewer) (*Preview, error) {
+			return p.Insert(ctx, testFrom, manufacturerId, in)
+		}},
+		{"insertDeviceDefinitionBatch", InsertBatchSQL(testChainID, testTableId, []contracts.DeviceDefinitionInput{in}), func(p *Previewer) (*Preview, error) {
+			return p.InsertBatch(ctx, testFrom, manufacturerId, []contracts.DeviceDefinitionInput{in})
+		}},
+		{"updateDeviceDefinition", UpdateSQL(testChainID, testTableId, update), func(p *Previewer) (*Preview, error) {
+			return p.Update(ctx, testFrom, manufacturerId, update)
+		}},
+		{"deleteDeviceDefinition", DeleteSQL(testChainID, testTableId, in.Id), func(p *Previewer) (*Preview, error) {
+			return p.Delete(ctx, testFrom, manufacturerId, in.Id)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			preview, err := tt.preview(newTestPreviewer(t, testTableId, tt.sql))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if preview.Method != tt.method || preview.TableId.Cmp(testTableId) != 0 || preview.SQL != tt.sql {
+				t.Errorf("preview = %s %s %q, want %s %s %q", preview.Method, preview.TableId, preview.SQL, tt.method, testTableId, tt.sql)
+			}
+			if !preview.Matches() {
+				t.Errorf("executed %q, want %q", preview.Executed, tt.sql)
+			}
+			if preview.Simulation.Err != nil {
+				t.Errorf("simulation error = %v", preview.Simulation.Err)
+			}
+		})
+	}
+}
+
+func TestPreviewMismatch(t *testing.T) {
+	in := validInput()
+	tests := []struct {
+		name     string
+		executed []string
+	}{
+		{"no RunSQL log", nil},
+		{"other statement", []string{DeleteSQL(testChainID, testTableId, in.Id)}},
+		{"two statements", []string{InsertSQL(testChainID, testTableId, in), InsertSQL(testChainID, testTableId, in)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview, err := newTestPreviewer(t, testTableId, tt.executed...).Insert(context.Background(), testFrom, big.NewInt(137), in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if preview.Matches() {
+				t.Errorf("preview matches with executed %q", preview.Executed)
+			}
+		})
+	}
+}
+
+func TestPreviewNoTable(t *testing.T) {
+	_, err := newTestPreviewer(t, new(big.Int)).Delete(context.Background(), testFrom, big.NewInt(137), "ford_bronco_2022")
+	if !errors.Is(err, ErrNoTable) {
+		t.Fatalf("error = %v, want %v", err, ErrNoTable)
+	}
+}
@@ -0,0 +1,76 @@
+package devicedefinition
+
+import (
+	"math/big"
+	"strings"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// columns is the column list of the module's INSERT statements.
+const columns = "id,model,year,metadata,ksuid,deviceType,imageURI"
+
+// TableName returns the Tableland name of a table, as SQLHelpers.toNameFromId:
+// <prefix>_<chainId>_<tableId>. The module creates its tables with an empty
+// prefix, e.g. "_137_42".
+func TableName(prefix string, chainID, tableId *big.Int) string {
+	return prefix + "_" + chainID.String() + "_" + tableId.String()
+}
+
+// InsertSQL returns the statement _insertDeviceDefinitionData sends to
+// Tableland for InsertDeviceDefinition.
+func InsertSQL(chainID, tableId *big.Int, in contracts.DeviceDefinitionInput) string {
+	return "INSERT INTO " + TableName("", chainID, tableId) + "(" + columns + ")VALUES(" + values(in) + ")"
+}
+
+// InsertBatchSQL returns the statement InsertDeviceDefinitionBatch sends to
+// Tableland.
+func InsertBatchSQL(chainID, tableId *big.Int, ins []contracts.DeviceDefinitionInput) string {
+	var b strings.Builder
+	b.WriteString("INSERT INTO " + TableName("", chainID, tableId) + "(" + columns + ")VALUES")
+	for i, in := range ins {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("(" + values(in) + ")")
+	}
+	return b.String()
+}
+
+// UpdateSQL returns the statement _updateDeviceDefinitionData sends to
+// Tableland for UpdateDeviceDefinition.
+func UpdateSQL(chainID, tableId *big.Int, in contracts.DeviceDefinitionUpdateInput) string {
+	setters := "metadata=" + quote(in.Metadata) +
+		", ksuid=" + quote(in.Ksuid) +
+		", deviceType=" + quote(in.DeviceType) +
+		", imageURI=" + quote(in.ImageURI)
+	return "UPDATE " + TableName("", chainID, tableId) + " SET " + setters + " WHERE id=" + quote(in.Id)
+}
+
+// DeleteSQL returns the statement _deleteDeviceDefinitionData sends to
+// Tableland for DeleteDeviceDefinition.
+func DeleteSQL(chainID, tableId *big.Int, id string) string {
+	return "DELETE FROM " + TableName("", chainID, tableId) + " WHERE id=" + quote(id)
+}
+
+// values is _convertDeviceDefinitionToString. Fields are quoted as they are,
+// see Escape.
+func values(in contracts.DeviceDefinitionInput) string {
+	year := "0"
+	if in.Year != nil {
+		year = in.Year.String()
+	}
+	return strings.Join([]string{
+		quote(in.Id),
+		quote(in.Model),
+		year,
+		quote(in.Metadata),
+		quote(in.Ksuid),
+		quote(in.DeviceType),
+		quote(in.ImageURI),
+	}, ",")
+}
+
+func quote(s string) string {
+	return "'" + s + "'"
+}
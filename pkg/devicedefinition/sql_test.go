@@ -0,0 +1,137 @@
+package devicedefinition
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/indexer"
+)
+
+var (
+	testChainID = big.NewInt(137)
+	testTableId = big.NewInt(42)
+	testTable   = "_137_42"
+)
+
+// newSQLTableland returns a SQLTableland over a fresh database with
+// testTable created.
+func newSQLTableland(t *testing.T) *SQLTableland {
+	t.Helper()
+	db, err := indexer.Open("file:" + filepath.Join(t.TempDir(), "tableland.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	tables := NewSQLTableland(db)
+	if err := tables.CreateTable(context.Background(), testTable); err != nil {
+		t.Fatal(err)
+	}
+	return tables
+}
+
+func TestTableName(t *testing.T) {
+	if got := TableName("", testChainID, testTableId); got != testTable {
+		t.Errorf("TableName = %s, want %s", got, testTable)
+	}
+	if got := TableName("dd", big.NewInt(80002), big.NewInt(7)); got != "dd_80002_7" {
+		t.Errorf("TableName = %s, want dd_80002_7", got)
+	}
+}
+
+// TestSQL checks the statements against the text SQLHelpers builds from the
+// strings concatenated by DeviceDefinitionTable.
+func TestSQL(t *testing.T) {
+	bronco := validInput()
+	cruiser := contracts.DeviceDefinitionInput{Id: "toyota_land_cruiser_1984", Model: "Land Cruiser ''70", Year: big.NewInt(1984)}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			"insert",
+			InsertSQL(testChainID, testTableId, bronco),
+			`INSERT INTO _137_42(id,model,year,metadata,ksuid,deviceType,imageURI)VALUES('ford_bronco_2022','Bronco',2022,'{"device_attributes":[{"name":"powertrain_type","value":"ICE"}]}','26G3iFH7Xc9Wvsw7pg6sD7uzoSS','vehicle','https://image.com/image.png')`,
+		},
+		{
+			"insert without year",
+			InsertSQL(testChainID, testTableId, contracts.DeviceDefinitionInput{Id: "a", Model: "b"}),
+			`INSERT INTO _137_42(id,model,year,metadata,ksuid,deviceType,imageURI)VALUES('a','b',0,'','','','')`,
+		},
+		{
+			"insert batch",
+			InsertBatchSQL(testChainID, testTableId, []contracts.DeviceDefinitionInput{bronco, cruiser}),
+			`INSERT INTO _137_42(id,model,year,metadata,ksuid,deviceType,imageURI)VALUES('ford_bronco_2022','Bronco',2022,'{"device_attributes":[{"name":"powertrain_type","value":"ICE"}]}','26G3iFH7Xc9Wvsw7pg6sD7uzoSS','vehicle','https://image.com/image.png'),('toyota_land_cruiser_1984','Land Cruiser ''70',1984,'','','','')`,
+		},
+		{
+			"update",
+			UpdateSQL(testChainID, testTableId, contracts.DeviceDefinitionUpdateInput{Id: "ford_bronco_2022", Metadata: "{}", DeviceType: "vehicle"}),
+			`UPDATE _137_42 SET metadata='{}', ksuid='', deviceType='vehicle', imageURI='' WHERE id='ford_bronco_2022'`,
+		},
+		{
+			"delete",
+			DeleteSQL(testChainID, testTableId, "o''brien"),
+			`DELETE FROM _137_42 WHERE id='o''brien'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("statement =\n%s\nwant\n%s", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSQLRoundTrip runs the statements of escaped values on SQLite and reads
+// the original values back.
+func TestSQLRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	tables := newSQLTableland(t)
+
+	cruiser := contracts.DeviceDefinitionInput{
+		Id:         "toyota_land_cruiser_'70",
+		Model:      "Land Cruiser '70",
+		Year:       big.NewInt(1984),
+		Metadata:   `{"nickname":"'70"}`,
+		DeviceType: "vehicle",
+	}
+	rows := []contracts.DeviceDefinitionInput{validInput(), cruiser}
+	if err := tables.Exec(ctx, InsertBatchSQL(testChainID, testTableId, []contracts.DeviceDefinitionInput{Escape(rows[0]), Escape(rows[1])})); err != nil {
+		t.Fatal(err)
+	}
+	got, err := tables.Rows(ctx, testTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, rows) {
+		t.Fatalf("rows = %+v, want %+v", got, rows)
+	}
+
+	update := contracts.DeviceDefinitionUpdateInput{Id: cruiser.Id, Metadata: `{"nickname":"BJ'70"}`, ImageURI: "https://image.com/'70.png"}
+	if err := tables.Exec(ctx, UpdateSQL(testChainID, testTableId, EscapeUpdate(update))); err != nil {
+		t.Fatal(err)
+	}
+	want := cruiser
+	want.Metadata, want.Ksuid, want.DeviceType, want.ImageURI = update.Metadata, update.Ksuid, update.DeviceType, update.ImageURI
+	if got, err := tables.Rows(ctx, testTable); err != nil || len(got) != 2 || !reflect.DeepEqual(got[1], want) {
+		t.Fatalf("rows after update = %+v, %v, want %+v last", got, err, want)
+	}
+
+	if err := tables.Exec(ctx, DeleteSQL(testChainID, testTableId, escape(cruiser.Id))); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := tables.Rows(ctx, testTable); err != nil || len(got) != 1 || got[0].Id != rows[0].Id {
+		t.Fatalf("rows after delete = %+v, %v", got, err)
+	}
+
+	// Unescaped, the quote ends the string literal early.
+	if err := tables.Exec(ctx, InsertSQL(testChainID, testTableId, cruiser)); err == nil {
+		t.Error("statement with an unescaped quote ran")
+	}
+}
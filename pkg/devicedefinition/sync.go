@@ -0,0 +1,250 @@
+package devicedefinition
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// defaultChunkSize is the number of device definitions per
+// InsertDeviceDefinitionBatch, as in scripts/tableland/tasks.ts.
+const defaultChunkSize = 50
+
+// Submitter sends transactions. *submitter.Submitter satisfies it.
+type Submitter interface {
+	Submit(ctx context.Context, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error)
+}
+
+// Update is a device definition whose mutable fields change.
+type Update struct {
+	Current contracts.DeviceDefinitionInput
+	Desired contracts.DeviceDefinitionUpdateInput
+}
+
+// changes returns the changed fields as `field "old" -> "new"`. Metadata is
+// compared as compact JSON, since the gateway returns it re-serialized.
+func (u Update) changes() []string {
+	var out []string
+	add := func(field, from, to string, same bool) {
+		if !same {
+			out = append(out, fmt.Sprintf("%s %q -> %q", field, from, to))
+		}
+	}
+	add("metadata", u.Current.Metadata, u.Desired.Metadata, sameJSON(u.Current.Metadata, u.Desired.Metadata))
+	add("ksuid", u.Current.Ksuid, u.Desired.Ksuid, u.Current.Ksuid == u.Desired.Ksuid)
+	add("deviceType", u.Current.DeviceType, u.Desired.DeviceType, u.Current.DeviceType == u.Desired.DeviceType)
+	add("imageURI", u.Current.ImageURI, u.Desired.ImageURI, u.Current.ImageURI == u.Desired.ImageURI)
+	return out
+}
+
+// sameJSON reports whether a and b are the same JSON text once compacted, or
+// the same string if either is not JSON.
+func sameJSON(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, []byte(a)) != nil || json.Compact(&cb, []byte(b)) != nil {
+		return false
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+// Plan is the set of writes that brings a device definition table to a
+// desired state. Values are unescaped, as stored in the table.
+type Plan struct {
+	// Deletes are the ids of the rows to delete. A row whose model or year
+	// changes is deleted and inserted again, since UpdateDeviceDefinition
+	// cannot change them.
+	Deletes []string
+	Updates []Update
+	Inserts []contracts.DeviceDefinitionInput
+}
+
+// Diff returns the plan turning the current rows of a table into desired.
+// Rows are matched by id. It fails if desired has two rows with the same id.
+func Diff(desired, current []contracts.DeviceDefinitionInput) (*Plan, error) {
+	want := make(map[string]contracts.DeviceDefinitionInput, len(desired))
+	for _, dd := range desired {
+		if _, ok := want[dd.Id]; ok {
+			return nil, fmt.Errorf("devicedefinition: duplicate id %q", dd.Id)
+		}
+		want[dd.Id] = dd
+	}
+
+	plan := &Plan{}
+	have := make(map[string]bool, len(current))
+	for _, cur := range current {
+		have[cur.Id] = true
+		dd, ok := want[cur.Id]
+		switch {
+		case !ok:
+			plan.Deletes = append(plan.Deletes, cur.Id)
+		case dd.Model != cur.Model || !sameYear(dd.Year, cur.Year):
+			plan.Deletes = append(plan.Deletes, cur.Id)
+			plan.Inserts = append(plan.Inserts, dd)
+		default:
+			u := Update{Current: cur, Desired: contracts.DeviceDefinitionUpdateInput{
+				Id:         dd.Id,
+				Metadata:   dd.Metadata,
+				Ksuid:      dd.Ksuid,
+				DeviceType: dd.DeviceType,
+				ImageURI:   dd.ImageURI,
+			}}
+			if len(u.changes()) > 0 {
+				plan.Updates = append(plan.Updates, u)
+			}
+		}
+	}
+	for _, dd := range desired {
+		if !have[dd.Id] {
+			plan.Inserts = append(plan.Inserts, dd)
+		}
+	}
+
+	sort.Strings(plan.Deletes)
+	sort.Slice(plan.Updates, func(i, j int) bool { return plan.Updates[i].Desired.Id < plan.Updates[j].Desired.Id })
+	sort.Slice(plan.Inserts, func(i, j int) bool { return plan.Inserts[i].Id < plan.Inserts[j].Id })
+	return plan, nil
+}
+
+func sameYear(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
+
+// Empty reports whether the table is already in the desired state.
+func (p *Plan) Empty() bool {
+	return len(p.Deletes) == 0 && len(p.Updates) == 0 && len(p.Inserts) == 0
+}
+
+// WriteTo writes the plan in a diff-like format, one line per row, followed
+// by a summary line.
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, id := range p.Deletes {
+		fmt.Fprintf(&b, "- %s\n", id)
+	}
+	for _, u := range p.Updates {
+		fmt.Fprintf(&b, "~ %s: %s\n", u.Desired.Id, strings.Join(u.changes(), ", "))
+	}
+	for _, dd := range p.Inserts {
+		fmt.Fprintf(&b, "+ %s: %s %s\n", dd.Id, dd.Model, dd.Year)
+	}
+	fmt.Fprintf(&b, "%d to delete, %d to update, %d to insert\n", len(p.Deletes), len(p.Updates), len(p.Inserts))
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// chunks splits the escaped inserts into batches of at most size.
+func (p *Plan) chunks(size int) [][]contracts.DeviceDefinitionInput {
+	if size <= 0 {
+		size = defaultChunkSize
+	}
+	var out [][]contracts.DeviceDefinitionInput
+	for start := 0; start < len(p.Inserts); start += size {
+		end := start + size
+		if end > len(p.Inserts) {
+			end = len(p.Inserts)
+		}
+		chunk := make([]contracts.DeviceDefinitionInput, end-start)
+		for i, dd := range p.Inserts[start:end] {
+			chunk[i] = Escape(dd)
+		}
+		out = append(out, chunk)
+	}
+	return out
+}
+
+// SQL returns the statements Tableland runs when the plan is applied with
+// chunkSize, in order. They can be executed on a SQLTableland to mirror the
+// table.
+func (p *Plan) SQL(chainID, tableId *big.Int, chunkSize int) []string {
+	var out []string
+	for _, id := range p.Deletes {
+		out = append(out, DeleteSQL(chainID, tableId, escape(id)))
+	}
+	for _, u := range p.Updates {
+		out = append(out, UpdateSQL(chainID, tableId, EscapeUpdate(u.Desired)))
+	}
+	for _, chunk := range p.chunks(chunkSize) {
+		out = append(out, InsertBatchSQL(chainID, tableId, chunk))
+	}
+	return out
+}
+
+// Validate checks every write of the plan with v, see Validator.
+func (p *Plan) Validate(v *Validator) error {
+	for _, u := range p.Updates {
+		if err := v.ValidateUpdate(EscapeUpdate(u.Desired)); err != nil {
+			return fmt.Errorf("update %s: %w", u.Desired.Id, err)
+		}
+	}
+	inserts := make([]contracts.DeviceDefinitionInput, len(p.Inserts))
+	for i, dd := range p.Inserts {
+		inserts[i] = Escape(dd)
+	}
+	return v.ValidateBatch(inserts)
+}
+
+// Apply validates the plan with a zero Validator and sends it for
+// manufacturerId through s: one DeleteDeviceDefinition per delete, then one
+// UpdateDeviceDefinition per update, then InsertDeviceDefinitionBatch with
+// chunkSize device definitions each, 50 if chunkSize is 0. Values are
+// escaped before they are sent. Nothing is sent if validation fails. It
+// returns the transactions sent, which are not waited for, including those
+// sent before a failure.
+func (p *Plan) Apply(ctx context.Context, registry *contracts.RegistryTransactor, s Submitter, manufacturerId *big.Int, chunkSize int) ([]*types.Transaction, error) {
+	if err := p.Validate(&Validator{}); err != nil {
+		return nil, err
+	}
+
+	var txs []*types.Transaction
+	send := func(desc string, fn func(*bind.TransactOpts) (*types.Transaction, error)) error {
+		tx, err := s.Submit(ctx, fn)
+		if err != nil {
+			return fmt.Errorf("%s: %w", desc, err)
+		}
+		txs = append(txs, tx)
+		return nil
+	}
+
+	for _, id := range p.Deletes {
+		id := escape(id)
+		if err := send("delete "+id, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return registry.DeleteDeviceDefinition(opts, manufacturerId, id)
+		}); err != nil {
+			return txs, err
+		}
+	}
+	for _, u := range p.Updates {
+		in := EscapeUpdate(u.Desired)
+		if err := send("update "+in.Id, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return registry.UpdateDeviceDefinition(opts, manufacturerId, in)
+		}); err != nil {
+			return txs, err
+		}
+	}
+	for _, chunk := range p.chunks(chunkSize) {
+		chunk := chunk
+		desc := fmt.Sprintf("insert %s..%s", chunk[0].Id, chunk[len(chunk)-1].Id)
+		if err := send(desc, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return registry.InsertDeviceDefinitionBatch(opts, manufacturerId, chunk)
+		}); err != nil {
+			return txs, err
+		}
+	}
+	return txs, nil
+}
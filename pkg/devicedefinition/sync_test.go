@@ -0,0 +1,326 @@
+package devicedefinition
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+func dd(id, model string, year int64, metadata string) contracts.DeviceDefinitionInput {
+	return contracts.DeviceDefinitionInput{Id: id, Model: model, Year: big.NewInt(year), Metadata: metadata}
+}
+
+func updateOf(cur contracts.DeviceDefinitionInput, metadata string) Update {
+	return Update{Current: cur, Desired: contracts.DeviceDefinitionUpdateInput{Id: cur.Id, Metadata: metadata}}
+}
+
+var (
+	bronco  = dd("ford_bronco_2022", "Bronco", 2022, `{"a":1}`)
+	cruiser = dd("toyota_land_cruiser_'70", "Land Cruiser '70", 1984, `{"nickname":"'70"}`)
+	mustang = dd("ford_mustang_2024", "Mustang", 2024, "")
+)
+
+// syncTests are shared by TestDiff and TestPlanSQL.
+var syncTests = []struct {
+	name             string
+	current, desired []contracts.DeviceDefinitionInput
+	want             *Plan
+}{
+	{
+		"unchanged",
+		[]contracts.DeviceDefinitionInput{bronco, cruiser},
+		[]contracts.DeviceDefinitionInput{cruiser, bronco},
+		&Plan{},
+	},
+	{
+		"metadata formatted differently",
+		[]contracts.DeviceDefinitionInput{bronco},
+		[]contracts.DeviceDefinitionInput{dd(bronco.Id, bronco.Model, 2022, "{ \"a\": 1 }\n")},
+		&Plan{},
+	},
+	{
+		"insert into an empty table",
+		nil,
+		[]contracts.DeviceDefinitionInput{mustang, cruiser, bronco},
+		&Plan{Inserts: []contracts.DeviceDefinitionInput{bronco, mustang, cruiser}},
+	},
+	{
+		"delete",
+		[]contracts.DeviceDefinitionInput{bronco, cruiser},
+		[]contracts.DeviceDefinitionInput{bronco},
+		&Plan{Deletes: []string{cruiser.Id}},
+	},
+	{
+		"update metadata",
+		[]contracts.DeviceDefinitionInput{bronco, cruiser},
+		[]contracts.DeviceDefinitionInput{dd(bronco.Id, bronco.Model, 2022, `{"a":2}`), dd(cruiser.Id, cruiser.Model, 1984, `{"nickname":"BJ'70"}`)},
+		&Plan{Updates: []Update{updateOf(bronco, `{"a":2}`), updateOf(cruiser, `{"nickname":"BJ'70"}`)}},
+	},
+	{
+		"model and year changes reinsert",
+		[]contracts.DeviceDefinitionInput{bronco, cruiser},
+		[]contracts.DeviceDefinitionInput{dd(bronco.Id, bronco.Model, 2023, bronco.Metadata), dd(cruiser.Id, "Land Cruiser 70", 1984, "")},
+		&Plan{
+			Deletes: []string{bronco.Id, cruiser.Id},
+			Inserts: []contracts.DeviceDefinitionInput{dd(bronco.Id, bronco.Model, 2023, bronco.Metadata), dd(cruiser.Id, "Land Cruiser 70", 1984, "")},
+		},
+	},
+	{
+		"mixed",
+		[]contracts.DeviceDefinitionInput{bronco, cruiser},
+		[]contracts.DeviceDefinitionInput{mustang, dd(cruiser.Id, cruiser.Model, 1984, "{}")},
+		&Plan{
+			Deletes: []string{bronco.Id},
+			Updates: []Update{updateOf(cruiser, "{}")},
+			Inserts: []contracts.DeviceDefinitionInput{mustang},
+		},
+	},
+}
+
+func TestDiff(t *testing.T) {
+	for _, tt := range syncTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.desired, tt.current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff =\n%+v\nwant\n%+v", got, tt.want)
+			}
+			if got.Empty() != reflect.DeepEqual(tt.want, &Plan{}) {
+				t.Errorf("Empty = %v", got.Empty())
+			}
+		})
+	}
+
+	if _, err := Diff([]contracts.DeviceDefinitionInput{bronco, bronco}, nil); err == nil {
+		t.Error("Diff accepted a duplicate id")
+	}
+}
+
+// TestPlanSQL runs the statements of each plan on a SQLTableland holding the
+// current rows and checks that the table ends up with the desired rows.
+func TestPlanSQL(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range syncTests {
+		for _, chunkSize := range []int{0, 1} {
+			t.Run(fmt.Sprintf("%s/chunk %d", tt.name, chunkSize), func(t *testing.T) {
+				tables := newSQLTableland(t)
+				for _, cur := range tt.current {
+					if err := tables.Exec(ctx, InsertSQL(testChainID, testTableId, Escape(cur))); err != nil {
+						t.Fatal(err)
+					}
+				}
+				current, err := tables.Rows(ctx, testTable)
+				if err != nil {
+					t.Fatal(err)
+				}
+				p, err := Diff(tt.desired, current)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, statement := range p.SQL(testChainID, testTableId, chunkSize) {
+					if err := tables.Exec(ctx, statement); err != nil {
+						t.Fatalf("%s: %v", statement, err)
+					}
+				}
+
+				got, err := tables.Rows(ctx, testTable)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// Diff ignores the formatting of metadata, so does the
+				// comparison.
+				p, err = Diff(tt.desired, got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !p.Empty() || len(got) != len(tt.desired) {
+					t.Errorf("rows = %+v, want %+v", got, tt.desired)
+				}
+			})
+		}
+	}
+}
+
+func TestPlanSQLChunks(t *testing.T) {
+	p := &Plan{Inserts: []contracts.DeviceDefinitionInput{bronco, mustang, cruiser}}
+	for _, tt := range []struct {
+		chunkSize int
+		want      int
+	}{{0, 1}, {1, 3}, {2, 2}, {3, 1}} {
+		if got := len(p.SQL(testChainID, testTableId, tt.chunkSize)); got != tt.want {
+			t.Errorf("chunk size %d: %d statements, want %d", tt.chunkSize, got, tt.want)
+		}
+	}
+}
+
+func TestPlanWriteTo(t *testing.T) {
+	p := &Plan{
+		Deletes: []string{bronco.Id},
+		Updates: []Update{updateOf(cruiser, "{}")},
+		Inserts: []contracts.DeviceDefinitionInput{mustang},
+	}
+	var b bytes.Buffer
+	if _, err := p.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `- ford_bronco_2022
+~ toyota_land_cruiser_'70: metadata "{\"nickname\":\"'70\"}" -> "{}"
++ ford_mustang_2024: Mustang 2024
+1 to delete, 1 to update, 1 to insert
+`
+	if b.String() != want {
+		t.Errorf("WriteTo =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+// fakeSubmitter signs the transactions built by send without sending them,
+// and fails from the call numbered failAt on if it is set.
+type fakeSubmitter struct {
+	failAt int
+	calls  int
+}
+
+var errSubmit = errors.New("submit failed")
+
+func (s *fakeSubmitter) Submit(_ context.Context, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	s.calls++
+	if s.failAt > 0 && s.calls >= s.failAt {
+		return nil, errSubmit
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, testChainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Nonce = big.NewInt(int64(s.calls))
+	opts.GasLimit = 1_000_000
+	opts.GasPrice = big.NewInt(1)
+	opts.NoSend = true
+	return send(opts)
+}
+
+// sentCall is a decoded registry call.
+type sentCall struct {
+	method string
+	args   []interface{}
+}
+
+func decodeCalls(t *testing.T, txs []*types.Transaction) []sentCall {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []sentCall
+	for _, tx := range txs {
+		if *tx.To() != testRegistry {
+			t.Fatalf("sent to %s, want %s", tx.To(), testRegistry)
+		}
+		method, err := parsed.MethodById(tx.Data())
+		if err != nil {
+			t.Fatal(err)
+		}
+		args, err := method.Inputs.Unpack(tx.Data()[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, sentCall{method.Name, args})
+	}
+	return out
+}
+
+func newTestTransactor(t *testing.T) *contracts.RegistryTransactor {
+	t.Helper()
+	registry, err := contracts.NewRegistryTransactor(testRegistry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestPlanApply(t *testing.T) {
+	manufacturerId := big.NewInt(137)
+	p := &Plan{
+		Deletes: []string{cruiser.Id},
+		Updates: []Update{updateOf(bronco, `{"a":2}`)},
+		Inserts: []contracts.DeviceDefinitionInput{mustang, cruiser},
+	}
+
+	txs, err := p.Apply(context.Background(), newTestTransactor(t), &fakeSubmitter{}, manufacturerId, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decodeCalls(t, txs)
+	names := make([]string, len(got))
+	for i, c := range got {
+		names[i] = c.method
+	}
+	wantNames := []string{"deleteDeviceDefinition", "updateDeviceDefinition", "insertDeviceDefinitionBatch", "insertDeviceDefinitionBatch"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("calls = %v, want %v", names, wantNames)
+	}
+	for _, c := range got {
+		if c.args[0].(*big.Int).Cmp(manufacturerId) != 0 {
+			t.Errorf("%s manufacturer = %v, want %s", c.method, c.args[0], manufacturerId)
+		}
+	}
+
+	// Values are sent escaped.
+	if id := got[0].args[1].(string); id != "toyota_land_cruiser_''70" {
+		t.Errorf("deleted id = %q", id)
+	}
+	if in := got[1].args[1].(struct {
+		Id         string `json:"id"`
+		Metadata   string `json:"metadata"`
+		Ksuid      string `json:"ksuid"`
+		DeviceType string `json:"deviceType"`
+		ImageURI   string `json:"imageURI"`
+	}); in.Id != bronco.Id || in.Metadata != `{"a":2}` {
+		t.Errorf("update = %+v", in)
+	}
+	batch := reflect.ValueOf(got[3].args[1])
+	if batch.Len() != 1 || batch.Index(0).FieldByName("Model").String() != "Land Cruiser ''70" {
+		t.Errorf("last batch = %+v, want the escaped %s", got[3].args[1], cruiser.Id)
+	}
+}
+
+func TestPlanApplyErrors(t *testing.T) {
+	ctx := context.Background()
+	p := &Plan{
+		Deletes: []string{cruiser.Id},
+		Inserts: []contracts.DeviceDefinitionInput{mustang},
+	}
+
+	sub := &fakeSubmitter{failAt: 2}
+	txs, err := p.Apply(ctx, newTestTransactor(t), sub, big.NewInt(137), 0)
+	if !errors.Is(err, errSubmit) {
+		t.Fatalf("error = %v, want %v", err, errSubmit)
+	}
+	if len(txs) != 1 {
+		t.Errorf("%d transactions returned, want the delete sent before the failure", len(txs))
+	}
+
+	invalid := &Plan{Inserts: []contracts.DeviceDefinitionInput{dd("x", "y", 1800, "")}}
+	sub = &fakeSubmitter{}
+	if _, err := invalid.Apply(ctx, newTestTransactor(t), sub, big.NewInt(137), 0); err == nil {
+		t.Error("invalid plan applied")
+	}
+	if sub.calls != 0 {
+		t.Errorf("%d transactions sent for an invalid plan", sub.calls)
+	}
+}
@@ -0,0 +1,305 @@
+package devicedefinition
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// Gateway URLs of the Tableland networks.
+const (
+	MainnetGatewayURL = "https://tableland.network"
+	TestnetGatewayURL = "https://testnets.tableland.network"
+)
+
+// createColumns is the schema of the tables created by
+// createDeviceDefinitionTable.
+const createColumns = "(id TEXT PRIMARY KEY, model TEXT NOT NULL, year INTEGER NOT NULL, metadata TEXT, ksuid TEXT, deviceType TEXT, imageURI TEXT, UNIQUE(model,year))"
+
+// ErrRowNotFound is returned by Tableland.Row for an id that is not in the
+// table.
+var ErrRowNotFound = errors.New("devicedefinition: device definition not found")
+
+// Tableland reads the rows of device definition tables.
+type Tableland interface {
+	// Rows returns every row of table, e.g. "_137_42", sorted by id.
+	Rows(ctx context.Context, table string) ([]contracts.DeviceDefinitionInput, error)
+	// Row returns the row of table with id, or ErrRowNotFound.
+	Row(ctx context.Context, table, id string) (contracts.DeviceDefinitionInput, error)
+}
+
+func selectRows(table string) string {
+	return "SELECT " + columns + " FROM " + table + " ORDER BY id"
+}
+
+func selectRow(table, id string) string {
+	return "SELECT " + columns + " FROM " + table + " WHERE id=" + quote(escape(id))
+}
+
+func rowNotFound(table, id string) error {
+	return fmt.Errorf("%w: %q in %s", ErrRowNotFound, id, table)
+}
+
+// Gateway is a Tableland backed by the query API of a Tableland gateway.
+type Gateway struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewGateway returns a Gateway for baseURL, e.g. MainnetGatewayURL. client
+// may be nil to use http.DefaultClient.
+func NewGateway(baseURL string, client *http.Client) *Gateway {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Gateway{baseURL: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+// gatewayRow is a row as returned by the gateway. The gateway returns JSON
+// text columns such as metadata either as strings or already parsed.
+type gatewayRow struct {
+	Id         string          `json:"id"`
+	Model      string          `json:"model"`
+	Year       json.Number     `json:"year"`
+	Metadata   json.RawMessage `json:"metadata"`
+	Ksuid      *string         `json:"ksuid"`
+	DeviceType *string         `json:"deviceType"`
+	ImageURI   *string         `json:"imageURI"`
+}
+
+func (g *Gateway) Rows(ctx context.Context, table string) ([]contracts.DeviceDefinitionInput, error) {
+	var rows []gatewayRow
+	if err := g.query(ctx, selectRows(table), &rows); err != nil {
+		return nil, err
+	}
+	return decodeRows(rows)
+}
+
+func (g *Gateway) Row(ctx context.Context, table, id string) (contracts.DeviceDefinitionInput, error) {
+	var rows []gatewayRow
+	if err := g.query(ctx, selectRow(table, id), &rows); err != nil {
+		return contracts.DeviceDefinitionInput{}, err
+	}
+	out, err := decodeRows(rows)
+	if err != nil {
+		return contracts.DeviceDefinitionInput{}, err
+	}
+	if len(out) == 0 {
+		return contracts.DeviceDefinitionInput{}, rowNotFound(table, id)
+	}
+	return out[0], nil
+}
+
+func decodeRows(rows []gatewayRow) ([]contracts.DeviceDefinitionInput, error) {
+	out := make([]contracts.DeviceDefinitionInput, len(rows))
+	for i, r := range rows {
+		year, ok := new(big.Int).SetString(r.Year.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("row %s: invalid year %q", r.Id, r.Year)
+		}
+		metadata, err := textColumn(r.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("row %s: invalid metadata: %w", r.Id, err)
+		}
+		out[i] = contracts.DeviceDefinitionInput{
+			Id:         r.Id,
+			Model:      r.Model,
+			Year:       year,
+			Metadata:   metadata,
+			Ksuid:      deref(r.Ksuid),
+			DeviceType: deref(r.DeviceType),
+			ImageURI:   deref(r.ImageURI),
+		}
+	}
+	return out, nil
+}
+
+func (g *Gateway) query(ctx context.Context, statement string, out interface{}) error {
+	q := url.Values{"statement": {statement}, "format": {"objects"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/api/v1/query?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		// The gateway answers an empty result with 404 "Row not found".
+		return json.Unmarshal([]byte("[]"), out)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("tableland gateway: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
+
+// textColumn returns a TEXT column as stored: a JSON string is unquoted, null
+// is empty and anything else is the JSON the gateway parsed.
+func textColumn(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	if !json.Valid(raw) {
+		return "", fmt.Errorf("invalid JSON %q", raw)
+	}
+	return string(raw), nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// SQLTableland is a Tableland stand-in backed by a SQLite database, such as
+// one opened with indexer.Open. Tableland validators run the statements on
+// SQLite too, so the statements built by this package can be executed on it
+// as they would be on Tableland.
+type SQLTableland struct {
+	db *sql.DB
+}
+
+// NewSQLTableland returns a SQLTableland over db.
+func NewSQLTableland(db *sql.DB) *SQLTableland {
+	return &SQLTableland{db: db}
+}
+
+// CreateTable creates table, e.g. TableName("", chainID, tableId), with the
+// schema of createDeviceDefinitionTable, if it does not exist.
+func (t *SQLTableland) CreateTable(ctx context.Context, table string) error {
+	_, err := t.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+table+createColumns)
+	return err
+}
+
+// Exec runs a statement built by this package, e.g. InsertSQL.
+func (t *SQLTableland) Exec(ctx context.Context, statement string) error {
+	_, err := t.db.ExecContext(ctx, statement)
+	return err
+}
+
+func (t *SQLTableland) Rows(ctx context.Context, table string) ([]contracts.DeviceDefinitionInput, error) {
+	return t.query(ctx, selectRows(table))
+}
+
+func (t *SQLTableland) Row(ctx context.Context, table, id string) (contracts.DeviceDefinitionInput, error) {
+	out, err := t.query(ctx, selectRow(table, id))
+	if err != nil {
+		return contracts.DeviceDefinitionInput{}, err
+	}
+	if len(out) == 0 {
+		return contracts.DeviceDefinitionInput{}, rowNotFound(table, id)
+	}
+	return out[0], nil
+}
+
+func (t *SQLTableland) query(ctx context.Context, statement string) ([]contracts.DeviceDefinitionInput, error) {
+	rows, err := t.db.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []contracts.DeviceDefinitionInput
+	for rows.Next() {
+		var (
+			dd                                    contracts.DeviceDefinitionInput
+			year                                  int64
+			metadata, ksuid, deviceType, imageURI sql.NullString
+		)
+		if err := rows.Scan(&dd.Id, &dd.Model, &year, &metadata, &ksuid, &deviceType, &imageURI); err != nil {
+			return nil, err
+		}
+		dd.Year = big.NewInt(year)
+		dd.Metadata, dd.Ksuid, dd.DeviceType, dd.ImageURI = metadata.String, ksuid.String, deviceType.String, imageURI.String
+		out = append(out, dd)
+	}
+	return out, rows.Err()
+}
+
+// MemoryTableland is an in-memory Tableland stand-in. The zero value is not
+// usable, see NewMemoryTableland.
+type MemoryTableland struct {
+	mu     sync.Mutex
+	tables map[string]map[string]contracts.DeviceDefinitionInput
+}
+
+// NewMemoryTableland returns a MemoryTableland without tables.
+func NewMemoryTableland() *MemoryTableland {
+	return &MemoryTableland{tables: make(map[string]map[string]contracts.DeviceDefinitionInput)}
+}
+
+// Put adds rows to table, replacing the rows with the same ids, and creates
+// the table if needed. Values are stored as given, unescaped.
+func (m *MemoryTableland) Put(table string, rows ...contracts.DeviceDefinitionInput) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tables[table]
+	if !ok {
+		t = make(map[string]contracts.DeviceDefinitionInput)
+		m.tables[table] = t
+	}
+	for _, row := range rows {
+		t[row.Id] = row
+	}
+}
+
+// Delete removes the rows of table with ids.
+func (m *MemoryTableland) Delete(table string, ids ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range ids {
+		delete(m.tables[table], id)
+	}
+}
+
+func (m *MemoryTableland) Rows(_ context.Context, table string) ([]contracts.DeviceDefinitionInput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tables[table]
+	if !ok {
+		return nil, fmt.Errorf("devicedefinition: no such table: %s", table)
+	}
+	out := make([]contracts.DeviceDefinitionInput, 0, len(t))
+	for _, row := range t {
+		out = append(out, row)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Id < out[j].Id })
+	return out, nil
+}
+
+func (m *MemoryTableland) Row(_ context.Context, table, id string) (contracts.DeviceDefinitionInput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tables[table]
+	if !ok {
+		return contracts.DeviceDefinitionInput{}, fmt.Errorf("devicedefinition: no such table: %s", table)
+	}
+	row, ok := t[id]
+	if !ok {
+		return contracts.DeviceDefinitionInput{}, rowNotFound(table, id)
+	}
+	return row, nil
+}
@@ -0,0 +1,213 @@
+package devicedefinition
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/manufacturerId"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/logscan"
+)
+
+// tablelandTablesABI is the ERC-721 ownerOf of TablelandTables, which owns a
+// table as a token.
+const tablelandTablesABI = `[{"type":"function","name":"ownerOf","stateMutability":"view",
+	"inputs":[{"name":"tokenId","type":"uint256"}],
+	"outputs":[{"name":"","type":"address"}]}]`
+
+// ManufacturerTable is a manufacturer and its device definition table.
+type ManufacturerTable struct {
+	ManufacturerId    *big.Int
+	ManufacturerName  string
+	ManufacturerOwner common.Address
+
+	// TableId is zero and TableName empty if the manufacturer has no table.
+	TableId    *big.Int
+	TableName  string
+	TableOwner common.Address
+	// CreatedBlock is the block of the DeviceDefinitionTableCreated event of
+	// the table, zero if the table was created outside the registry and
+	// assigned with SetDeviceDefinitionTable.
+	CreatedBlock uint64
+	// SetBlock is the block of the last ManufacturerTableSet event of the
+	// manufacturer.
+	SetBlock uint64
+}
+
+// HasTable reports whether the manufacturer has a device definition table.
+func (t *ManufacturerTable) HasTable() bool {
+	return t.TableId != nil && t.TableId.Sign() != 0
+}
+
+// OwnerMismatch reports whether the table token is held by another account
+// than the owner of the manufacturer node, e.g. after the node was
+// transferred. Writes are not tied to either owner: createDeviceDefinitionTable
+// makes the registry the controller of the table, so the table owner cannot
+// write to it through Tableland directly, and the registry accepts writes
+// from any account with MANUFACTURER_INSERT_DD_PRIVILEGE on the node, see
+// hasPrivilege. The table owner can however replace the registry as the
+// controller.
+func (t *ManufacturerTable) OwnerMismatch() bool {
+	return t.HasTable() && t.TableOwner != t.ManufacturerOwner
+}
+
+// TablesBackend is the chain access needed by LoadTables.
+type TablesBackend interface {
+	bind.ContractCaller
+	logscan.Backend
+}
+
+var _ TablesBackend = (*ethclient.Client)(nil)
+
+// Range is the block range LoadTables reads the table events from. Owners
+// and table ids are read at its ToBlock, the head if zero.
+type Range = logscan.Range
+
+// TablesConfig configures LoadTables.
+type TablesConfig struct {
+	// Registry is the DIMORegistry address.
+	Registry common.Address
+	// ManufacturerId is the ManufacturerId NFT address.
+	ManufacturerId common.Address
+	// TablelandTables is the TablelandTables address.
+	TablelandTables common.Address
+	Range
+}
+
+// LoadTables lists every manufacturer minted by the registry with its device
+// definition table, sorted by manufacturer id. Manufacturers are found from
+// ManufacturerNodeMinted events and creation blocks from
+// DeviceDefinitionTableCreated and ManufacturerTableSet events, while the
+// table ids, names and owners are read from the contracts.
+func LoadTables(ctx context.Context, backend TablesBackend, cfg TablesConfig) ([]*ManufacturerTable, error) {
+	var err error
+	if cfg.Range, err = cfg.Range.Resolve(ctx, backend); err != nil {
+		return nil, err
+	}
+
+	byId, err := loadTableEvents(ctx, backend, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := contracts.NewRegistryCaller(cfg.Registry, backend)
+	if err != nil {
+		return nil, err
+	}
+	manufacturers, err := manufacturerId.NewManufacturerIdCaller(cfg.ManufacturerId, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(tablelandTablesABI))
+	if err != nil {
+		return nil, err
+	}
+	tables := bind.NewBoundContract(cfg.TablelandTables, parsed, backend, nil, nil)
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(cfg.ToBlock)}
+	out := make([]*ManufacturerTable, 0, len(byId))
+	for _, t := range byId {
+		if t.ManufacturerOwner, err = manufacturers.OwnerOf(opts, t.ManufacturerId); err != nil {
+			return nil, fmt.Errorf("manufacturer %s: failed to get owner: %w", t.ManufacturerId, err)
+		}
+		if t.TableId, err = registry.GetDeviceDefinitionTableId(opts, t.ManufacturerId); err != nil {
+			return nil, fmt.Errorf("manufacturer %s: failed to get table id: %w", t.ManufacturerId, err)
+		}
+		if t.HasTable() {
+			if t.TableName, err = registry.GetDeviceDefinitionTableName(opts, t.ManufacturerId); err != nil {
+				return nil, fmt.Errorf("manufacturer %s: failed to get table name: %w", t.ManufacturerId, err)
+			}
+			var res []interface{}
+			if err := tables.Call(opts, &res, "ownerOf", t.TableId); err != nil {
+				return nil, fmt.Errorf("table %s: failed to get owner: %w", t.TableId, err)
+			}
+			t.TableOwner = res[0].(common.Address)
+		}
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ManufacturerId.Cmp(out[j].ManufacturerId) < 0 })
+	return out, nil
+}
+
+// loadTableEvents replays the manufacturer and table events of the registry.
+// The creation block is only kept for the table currently set.
+func loadTableEvents(ctx context.Context, backend TablesBackend, cfg TablesConfig) (map[string]*ManufacturerTable, error) {
+	var (
+		byId    = make(map[string]*ManufacturerTable)
+		created = make(map[string]uint64)
+		set     = make(map[string]*big.Int)
+	)
+	get := func(id *big.Int) *ManufacturerTable {
+		t, ok := byId[id.String()]
+		if !ok {
+			t = &ManufacturerTable{ManufacturerId: id}
+			byId[id.String()] = t
+		}
+		return t
+	}
+	err := logscan.Scan(ctx, backend, cfg.Registry, cfg.Range, []string{"ManufacturerNodeMinted", "DeviceDefinitionTableCreated", "ManufacturerTableSet"},
+		func(ev contracts.RegistryEvent) error {
+			switch ev := ev.(type) {
+			case *contracts.RegistryManufacturerNodeMinted:
+				get(ev.TokenId).ManufacturerName = ev.Name
+			case *contracts.RegistryDeviceDefinitionTableCreated:
+				created[ev.TableId.String()] = ev.Raw.BlockNumber
+			case *contracts.RegistryManufacturerTableSet:
+				get(ev.ManufacturerId).SetBlock = ev.Raw.BlockNumber
+				set[ev.ManufacturerId.String()] = ev.TableId
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	for id, tableId := range set {
+		byId[id].CreatedBlock = created[tableId.String()]
+	}
+	return byId, nil
+}
+
+// WriteTablesCSV writes tables as CSV, one row per manufacturer, with the
+// noTable and ownerMismatch columns flagging the manufacturers to look at.
+func WriteTablesCSV(w io.Writer, tables []*ManufacturerTable) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{
+		"manufacturerId", "manufacturerName", "manufacturerOwner",
+		"tableId", "tableName", "tableOwner", "createdBlock", "setBlock",
+		"noTable", "ownerMismatch",
+	}); err != nil {
+		return err
+	}
+	for _, t := range tables {
+		var tableId, tableOwner, createdBlock, setBlock string
+		if t.HasTable() {
+			tableId, tableOwner = t.TableId.String(), t.TableOwner.Hex()
+		}
+		if t.CreatedBlock != 0 {
+			createdBlock = strconv.FormatUint(t.CreatedBlock, 10)
+		}
+		if t.SetBlock != 0 {
+			setBlock = strconv.FormatUint(t.SetBlock, 10)
+		}
+		if err := cw.Write([]string{
+			t.ManufacturerId.String(), t.ManufacturerName, t.ManufacturerOwner.Hex(),
+			tableId, t.TableName, tableOwner, createdBlock, setBlock,
+			strconv.FormatBool(!t.HasTable()), strconv.FormatBool(t.OwnerMismatch()),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
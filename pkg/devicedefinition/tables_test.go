@@ -0,0 +1,164 @@
+package devicedefinition
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/manufacturerId"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ TablesBackend = simulated.Client(nil)
+
+var (
+	testManufacturerId = common.HexToAddress("0x3b07e2A2ABdd0A9B8F7878bdE6487c502164B9dd")
+	nodeOwner          = common.HexToAddress("0xa1")
+	otherOwner         = common.HexToAddress("0xb2")
+)
+
+// tablesBackend reads logs from a simulated chain and answers the reads of
+// LoadTables from the owners and tables maps, recording the blocks read at.
+type tablesBackend struct {
+	simulated.Client
+	registry    common.Address
+	owners      map[int64]common.Address // by manufacturer id
+	tables      map[int64]int64          // table id by manufacturer id
+	tableOwners map[int64]common.Address // by table id
+	blocks      map[uint64]bool
+}
+
+func (b *tablesBackend) CallContract(_ context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	b.blocks[block.Uint64()] = true
+
+	var parsed *abi.ABI
+	switch *msg.To {
+	case b.registry:
+		parsed, _ = contracts.RegistryMetaData.GetAbi()
+	case testManufacturerId:
+		parsed, _ = manufacturerId.ManufacturerIdMetaData.GetAbi()
+	case testTablelandTables:
+		tables, err := abi.JSON(strings.NewReader(tablelandTablesABI))
+		if err != nil {
+			return nil, err
+		}
+		parsed = &tables
+	default:
+		return nil, fmt.Errorf("unexpected call to %s", msg.To)
+	}
+	method, err := parsed.MethodById(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	id := args[0].(*big.Int).Int64()
+	switch {
+	case *msg.To == testManufacturerId && method.Name == "ownerOf":
+		return method.Outputs.Pack(b.owners[id])
+	case *msg.To == testTablelandTables && method.Name == "ownerOf":
+		return method.Outputs.Pack(b.tableOwners[id])
+	case method.Name == "getDeviceDefinitionTableId":
+		return method.Outputs.Pack(big.NewInt(b.tables[id]))
+	case method.Name == "getDeviceDefinitionTableName":
+		return method.Outputs.Pack(TableName("", testChainID, big.NewInt(b.tables[id])))
+	}
+	return nil, fmt.Errorf("unexpected call to %s", method.Name)
+}
+
+func TestLoadTables(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	from := c.Head(t) + 1
+
+	// Ford gets a table from the registry, Toyota one created by the
+	// registry and then replaced by a table of another owner, Tesla none.
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Ford", big.NewInt(1), nodeOwner)
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Toyota", big.NewInt(2), nodeOwner)
+	c.Commit()
+	c.EmitEvent(t, registry, "DeviceDefinitionTableCreated", nodeOwner, big.NewInt(1), big.NewInt(10))
+	c.EmitEvent(t, registry, "ManufacturerTableSet", big.NewInt(1), big.NewInt(10))
+	c.EmitEvent(t, registry, "DeviceDefinitionTableCreated", nodeOwner, big.NewInt(2), big.NewInt(11))
+	c.EmitEvent(t, registry, "ManufacturerTableSet", big.NewInt(2), big.NewInt(11))
+	c.Commit()
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Tesla", big.NewInt(3), nodeOwner)
+	c.EmitEvent(t, registry, "ManufacturerTableSet", big.NewInt(2), big.NewInt(12))
+	c.Commit()
+	to := c.Head(t)
+	// Past ToBlock, ignored.
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Rivian", big.NewInt(4), nodeOwner)
+	c.Commit()
+
+	backend := &tablesBackend{
+		Client:      c.Client,
+		registry:    registry,
+		owners:      map[int64]common.Address{1: nodeOwner, 2: nodeOwner, 3: nodeOwner},
+		tables:      map[int64]int64{1: 10, 2: 12},
+		tableOwners: map[int64]common.Address{10: nodeOwner, 12: otherOwner},
+		blocks:      make(map[uint64]bool),
+	}
+	tables, err := LoadTables(context.Background(), backend, TablesConfig{
+		Registry:        registry,
+		ManufacturerId:  testManufacturerId,
+		TablelandTables: testTablelandTables,
+		Range:           Range{FromBlock: from, ToBlock: to, ChunkSize: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.blocks) != 1 || !backend.blocks[to] {
+		t.Errorf("read at blocks %v, want %d", backend.blocks, to)
+	}
+
+	var b bytes.Buffer
+	if err := WriteTablesCSV(&b, tables); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf(`manufacturerId,manufacturerName,manufacturerOwner,tableId,tableName,tableOwner,createdBlock,setBlock,noTable,ownerMismatch
+1,Ford,%[1]s,10,_137_10,%[1]s,%[3]d,%[3]d,false,false
+2,Toyota,%[1]s,12,_137_12,%[2]s,,%[4]d,false,true
+3,Tesla,%[1]s,,,,,,true,false
+`, nodeOwner.Hex(), otherOwner.Hex(), from+1, from+2)
+	if b.String() != want {
+		t.Errorf("WriteTablesCSV =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestLoadTablesHead(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Ford", big.NewInt(1), nodeOwner)
+	c.Commit()
+
+	backend := &tablesBackend{
+		Client:   c.Client,
+		registry: registry,
+		owners:   map[int64]common.Address{1: nodeOwner},
+		blocks:   make(map[uint64]bool),
+	}
+	tables, err := LoadTables(context.Background(), backend, TablesConfig{
+		Registry:        registry,
+		ManufacturerId:  testManufacturerId,
+		TablelandTables: testTablelandTables,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 1 || tables[0].ManufacturerName != "Ford" || tables[0].HasTable() {
+		t.Fatalf("tables = %+v, want Ford without a table", tables)
+	}
+	if head := c.Head(t); !backend.blocks[head] {
+		t.Errorf("read at blocks %v, want the head %d", backend.blocks, head)
+	}
+}
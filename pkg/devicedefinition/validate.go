@@ -0,0 +1,290 @@
+// Package devicedefinition checks, previews and syncs the device definitions
+// written to the manufacturers' Tableland tables by the DeviceDefinitionTable
+// module, and reports who owns those tables.
+package devicedefinition
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+const (
+	// defaultMinYear is the year of the first production automobile.
+	defaultMinYear = 1886
+	// defaultYearsAhead is how many years past the current one models are
+	// announced.
+	defaultYearsAhead = 2
+
+	ksuidLength = 27
+	// maxKsuid is the largest KSUID, 2^160-1 in base62.
+	maxKsuid = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+// FieldError is an invalid field of a device definition.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+// Errors are the invalid fields of one device definition.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	return "devicedefinition: invalid device definition: " + e.join()
+}
+
+func (e Errors) join() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the field errors, for errors.As.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Field returns the error of field, or nil if it is valid.
+func (e Errors) Field(field string) *FieldError {
+	for _, err := range e {
+		if err.Field == field {
+			return err
+		}
+	}
+	return nil
+}
+
+// ItemError is an invalid device definition of a batch.
+type ItemError struct {
+	Index  int
+	Id     string
+	Errors Errors
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("device definition %d (%s): %s", e.Index, e.Id, e.Errors.join())
+}
+
+// BatchErrors are the invalid device definitions of a batch, in batch order.
+type BatchErrors []*ItemError
+
+func (e BatchErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "devicedefinition: invalid batch: " + strings.Join(msgs, "; ")
+}
+
+// Validator checks device definitions before they are sent. The module
+// concatenates every string field between single quotes into the Tableland
+// statement without escaping, so a field holding an apostrophe breaks or
+// alters the statement. Validator rejects such fields unless the quotes are
+// escaped by doubling them, as Escape does. The zero value is ready to use.
+type Validator struct {
+	// MinYear and MaxYear bound the model year. They default to 1886 and two
+	// years after the current year.
+	MinYear int64
+	MaxYear int64
+}
+
+// Validate checks a device definition passed to InsertDeviceDefinition. It
+// returns Errors if any field is invalid.
+func (v *Validator) Validate(in contracts.DeviceDefinitionInput) error {
+	var errs Errors
+	errs = checkRequired(errs, "Id", in.Id)
+	errs = checkRequired(errs, "Model", in.Model)
+	errs = v.checkYear(errs, in.Year)
+	errs = checkCommon(errs, in.Metadata, in.Ksuid, in.DeviceType, in.ImageURI)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateUpdate checks a device definition passed to
+// UpdateDeviceDefinition. It returns Errors if any field is invalid.
+func (v *Validator) ValidateUpdate(in contracts.DeviceDefinitionUpdateInput) error {
+	var errs Errors
+	errs = checkRequired(errs, "Id", in.Id)
+	errs = checkCommon(errs, in.Metadata, in.Ksuid, in.DeviceType, in.ImageURI)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateBatch checks the device definitions passed to
+// InsertDeviceDefinitionBatch, including that no two share an id or a model
+// and year, which the table's constraints reject. It returns BatchErrors if
+// any device definition is invalid.
+func (v *Validator) ValidateBatch(ins []contracts.DeviceDefinitionInput) error {
+	var (
+		batchErrs BatchErrors
+		ids       = make(map[string]int)
+		models    = make(map[string]int)
+	)
+	for i, in := range ins {
+		var errs Errors
+		if err := v.Validate(in); err != nil {
+			errs = err.(Errors)
+		}
+		if first, ok := ids[in.Id]; ok {
+			errs = append(errs, &FieldError{Field: "Id", Reason: fmt.Sprintf("duplicates device definition %d", first)})
+		} else {
+			ids[in.Id] = i
+		}
+		if in.Year != nil {
+			key := in.Model + "\x00" + in.Year.String()
+			if first, ok := models[key]; ok {
+				errs = append(errs, &FieldError{Field: "Model", Reason: fmt.Sprintf("model and year duplicate device definition %d", first)})
+			} else {
+				models[key] = i
+			}
+		}
+		if len(errs) > 0 {
+			batchErrs = append(batchErrs, &ItemError{Index: i, Id: in.Id, Errors: errs})
+		}
+	}
+	if len(batchErrs) > 0 {
+		return batchErrs
+	}
+	return nil
+}
+
+// Escape returns in with the single quotes of its string fields doubled, so
+// that the statement built by the module stores them verbatim. The emitted
+// DeviceDefinitionInserted event carries the escaped id and model.
+func Escape(in contracts.DeviceDefinitionInput) contracts.DeviceDefinitionInput {
+	in.Id = escape(in.Id)
+	in.Model = escape(in.Model)
+	in.Metadata = escape(in.Metadata)
+	in.Ksuid = escape(in.Ksuid)
+	in.DeviceType = escape(in.DeviceType)
+	in.ImageURI = escape(in.ImageURI)
+	return in
+}
+
+// EscapeUpdate is Escape for an update.
+func EscapeUpdate(in contracts.DeviceDefinitionUpdateInput) contracts.DeviceDefinitionUpdateInput {
+	in.Id = escape(in.Id)
+	in.Metadata = escape(in.Metadata)
+	in.Ksuid = escape(in.Ksuid)
+	in.DeviceType = escape(in.DeviceType)
+	in.ImageURI = escape(in.ImageURI)
+	return in
+}
+
+func escape(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// unescape returns the value stored for a quoted field, and false if s has a
+// single quote that is not doubled.
+func unescape(s string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\'' {
+			if i+1 >= len(s) || s[i+1] != '\'' {
+				return "", false
+			}
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String(), true
+}
+
+// checkQuoted checks that s can be placed between single quotes, and returns
+// the value that would be stored.
+func checkQuoted(errs Errors, field, s string) (Errors, string, bool) {
+	value, ok := unescape(s)
+	if !ok {
+		return append(errs, &FieldError{Field: field, Reason: "unescaped single quote, see Escape"}), "", false
+	}
+	for _, r := range value {
+		if r == 0 || (unicode.IsControl(r) && r != '\n' && r != '\t') {
+			return append(errs, &FieldError{Field: field, Reason: fmt.Sprintf("control character %U", r)}), "", false
+		}
+	}
+	return errs, value, true
+}
+
+func checkRequired(errs Errors, field, s string) Errors {
+	errs, value, ok := checkQuoted(errs, field, s)
+	if ok && strings.TrimSpace(value) == "" {
+		errs = append(errs, &FieldError{Field: field, Reason: "required"})
+	}
+	return errs
+}
+
+func checkCommon(errs Errors, metadata, ksuid, deviceType, imageURI string) Errors {
+	errs, value, ok := checkQuoted(errs, "Metadata", metadata)
+	if ok && value != "" && !json.Valid([]byte(value)) {
+		errs = append(errs, &FieldError{Field: "Metadata", Reason: "not valid JSON"})
+	}
+
+	if errs, value, ok = checkQuoted(errs, "Ksuid", ksuid); ok && value != "" && !validKsuid(value) {
+		errs = append(errs, &FieldError{Field: "Ksuid", Reason: "not a 27 character base62 KSUID"})
+	}
+
+	errs, _, _ = checkQuoted(errs, "DeviceType", deviceType)
+
+	if errs, value, ok = checkQuoted(errs, "ImageURI", imageURI); ok && value != "" {
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" {
+			errs = append(errs, &FieldError{Field: "ImageURI", Reason: "not an absolute URI"})
+		}
+	}
+	return errs
+}
+
+func (v *Validator) checkYear(errs Errors, year *big.Int) Errors {
+	minYear, maxYear := v.MinYear, v.MaxYear
+	if minYear == 0 {
+		minYear = defaultMinYear
+	}
+	if maxYear == 0 {
+		maxYear = int64(time.Now().Year() + defaultYearsAhead)
+	}
+	switch {
+	case year == nil:
+		return append(errs, &FieldError{Field: "Year", Reason: "required"})
+	case !year.IsInt64() || year.Int64() < minYear || year.Int64() > maxYear:
+		return append(errs, &FieldError{Field: "Year", Reason: fmt.Sprintf("%s is not between %d and %d", year, minYear, maxYear)})
+	}
+	return errs
+}
+
+// validKsuid reports whether s is a KSUID in its string form: 27 base62
+// digits encoding at most 160 bits.
+func validKsuid(s string) bool {
+	if len(s) != ksuidLength {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z') {
+			return false
+		}
+	}
+	// Base62 digits sort in ASCII order, so equal-length strings compare
+	// like the numbers they encode.
+	return s <= maxKsuid
+}
@@ -0,0 +1,219 @@
+package devicedefinition
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var testValidator = &Validator{MinYear: 1900, MaxYear: 2030}
+
+func validInput() contracts.DeviceDefinitionInput {
+	return contracts.DeviceDefinitionInput{
+		Id:         "ford_bronco_2022",
+		Model:      "Bronco",
+		Year:       big.NewInt(2022),
+		Metadata:   `{"device_attributes":[{"name":"powertrain_type","value":"ICE"}]}`,
+		Ksuid:      "26G3iFH7Xc9Wvsw7pg6sD7uzoSS",
+		DeviceType: "vehicle",
+		ImageURI:   "https://image.com/image.png",
+	}
+}
+
+// errorFields returns the invalid fields of err, which must be Errors or nil,
+// with their reasons.
+func errorFields(t *testing.T, err error) map[string]string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("error = %v, want Errors", err)
+	}
+	out := make(map[string]string, len(errs))
+	for _, e := range errs {
+		out[e.Field] = e.Reason
+	}
+	return out
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*contracts.DeviceDefinitionInput)
+		want   map[string]string
+	}{
+		{"valid", func(*contracts.DeviceDefinitionInput) {}, nil},
+		{"optional fields empty", func(in *contracts.DeviceDefinitionInput) {
+			in.Metadata, in.Ksuid, in.DeviceType, in.ImageURI = "", "", "", ""
+		}, nil},
+		{"escaped quote", func(in *contracts.DeviceDefinitionInput) { in.Model = "Land Cruiser ''70" }, nil},
+		{"unescaped quote", func(in *contracts.DeviceDefinitionInput) { in.Model = "Land Cruiser '70" },
+			map[string]string{"Model": "unescaped single quote, see Escape"}},
+		{"quote at the end", func(in *contracts.DeviceDefinitionInput) { in.Id = "ford'" },
+			map[string]string{"Id": "unescaped single quote, see Escape"}},
+		{"statement injection", func(in *contracts.DeviceDefinitionInput) { in.DeviceType = "x');DROP TABLE t;--" },
+			map[string]string{"DeviceType": "unescaped single quote, see Escape"}},
+		{"control character", func(in *contracts.DeviceDefinitionInput) { in.Model = "Bronco\x00" },
+			map[string]string{"Model": "control character U+0000"}},
+		{"newline and tab", func(in *contracts.DeviceDefinitionInput) { in.Metadata = "{\n\t\"a\": 1\n}" }, nil},
+		{"missing id and model", func(in *contracts.DeviceDefinitionInput) { in.Id, in.Model = "", " " },
+			map[string]string{"Id": "required", "Model": "required"}},
+		{"missing year", func(in *contracts.DeviceDefinitionInput) { in.Year = nil },
+			map[string]string{"Year": "required"}},
+		{"year too old", func(in *contracts.DeviceDefinitionInput) { in.Year = big.NewInt(1899) },
+			map[string]string{"Year": "1899 is not between 1900 and 2030"}},
+		{"year too new", func(in *contracts.DeviceDefinitionInput) { in.Year = big.NewInt(2031) },
+			map[string]string{"Year": "2031 is not between 1900 and 2030"}},
+		{"year bounds", func(in *contracts.DeviceDefinitionInput) { in.Year = big.NewInt(2030) }, nil},
+		{"invalid metadata", func(in *contracts.DeviceDefinitionInput) { in.Metadata = "{" },
+			map[string]string{"Metadata": "not valid JSON"}},
+		{"metadata with escaped quote", func(in *contracts.DeviceDefinitionInput) { in.Metadata = `{"model":"Cruiser ''70"}` }, nil},
+		{"short ksuid", func(in *contracts.DeviceDefinitionInput) { in.Ksuid = "26G3iFH7Xc9Wvsw7pg6sD7uzoS" },
+			map[string]string{"Ksuid": "not a 27 character base62 KSUID"}},
+		{"ksuid not base62", func(in *contracts.DeviceDefinitionInput) { in.Ksuid = "26G3iFH7Xc9Wvsw7pg6sD7uzo-S" },
+			map[string]string{"Ksuid": "not a 27 character base62 KSUID"}},
+		{"max ksuid", func(in *contracts.DeviceDefinitionInput) { in.Ksuid = maxKsuid }, nil},
+		{"ksuid over 160 bits", func(in *contracts.DeviceDefinitionInput) { in.Ksuid = "aWgEPTl1tmebfsQzFP4bxwgy80W" },
+			map[string]string{"Ksuid": "not a 27 character base62 KSUID"}},
+		{"relative image URI", func(in *contracts.DeviceDefinitionInput) { in.ImageURI = "image.png" },
+			map[string]string{"ImageURI": "not an absolute URI"}},
+		{"ipfs image URI", func(in *contracts.DeviceDefinitionInput) { in.ImageURI = "ipfs://bafy" }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := validInput()
+			tt.modify(&in)
+			got := errorFields(t, testValidator.Validate(in))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("invalid fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDefaultYears(t *testing.T) {
+	in := validInput()
+	in.Year = big.NewInt(1885)
+	if got := errorFields(t, (&Validator{}).Validate(in)); got["Year"] == "" {
+		t.Errorf("year 1885 accepted by the zero Validator")
+	}
+	in.Year = big.NewInt(1886)
+	if err := (&Validator{}).Validate(in); err != nil {
+		t.Errorf("year 1886: %v", err)
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	in := contracts.DeviceDefinitionUpdateInput{Id: "ford_bronco_2022", Metadata: "{}"}
+	if err := testValidator.ValidateUpdate(in); err != nil {
+		t.Fatal(err)
+	}
+
+	in = contracts.DeviceDefinitionUpdateInput{Id: "o'brien", Metadata: "[", ImageURI: "image.png"}
+	want := map[string]string{
+		"Id":       "unescaped single quote, see Escape",
+		"Metadata": "not valid JSON",
+		"ImageURI": "not an absolute URI",
+	}
+	if got := errorFields(t, testValidator.ValidateUpdate(in)); !reflect.DeepEqual(got, want) {
+		t.Errorf("invalid fields = %v, want %v", got, want)
+	}
+}
+
+func TestValidateBatch(t *testing.T) {
+	first := validInput()
+	sameId := validInput()
+	sameId.Model = "Bronco Sport"
+	sameModel := validInput()
+	sameModel.Id = "ford_bronco_2022_2"
+	invalid := validInput()
+	invalid.Id = "ford_bronco_2021"
+	invalid.Year = nil
+
+	err := testValidator.ValidateBatch([]contracts.DeviceDefinitionInput{first, sameId, sameModel, invalid})
+	var batchErrs BatchErrors
+	if !errors.As(err, &batchErrs) {
+		t.Fatalf("error = %v, want BatchErrors", err)
+	}
+	got := make(map[int]map[string]string)
+	for _, e := range batchErrs {
+		got[e.Index] = errorFields(t, e.Errors)
+	}
+	want := map[int]map[string]string{
+		1: {"Id": "duplicates device definition 0"},
+		2: {"Model": "model and year duplicate device definition 0"},
+		3: {"Year": "required"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("batch errors = %v, want %v", got, want)
+	}
+
+	if err := testValidator.ValidateBatch([]contracts.DeviceDefinitionInput{first, sameModel}); err == nil {
+		t.Error("same model and year accepted")
+	}
+	sameModel.Year = big.NewInt(2023)
+	if err := testValidator.ValidateBatch([]contracts.DeviceDefinitionInput{first, sameModel}); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestEscape checks that every escaped field passes validation and stores
+// the original value.
+func TestEscape(t *testing.T) {
+	in := contracts.DeviceDefinitionInput{
+		Id:         "toyota_land_cruiser_'70",
+		Model:      "Land Cruiser '70",
+		Year:       big.NewInt(1984),
+		Metadata:   `{"nickname":"'70"}`,
+		Ksuid:      "26G3iFH7Xc9Wvsw7pg6sD7uzoSS",
+		DeviceType: "o'vehicle",
+		ImageURI:   "https://image.com/'70.png",
+	}
+	escaped := Escape(in)
+	if err := testValidator.Validate(escaped); err != nil {
+		t.Fatal(err)
+	}
+	if escaped.Model != "Land Cruiser ''70" {
+		t.Errorf("escaped model = %q", escaped.Model)
+	}
+	for _, pair := range [][2]string{
+		{escaped.Id, in.Id},
+		{escaped.Model, in.Model},
+		{escaped.Metadata, in.Metadata},
+		{escaped.Ksuid, in.Ksuid},
+		{escaped.DeviceType, in.DeviceType},
+		{escaped.ImageURI, in.ImageURI},
+	} {
+		if got, ok := unescape(pair[0]); !ok || got != pair[1] {
+			t.Errorf("unescape(%q) = %q, %v, want %q", pair[0], got, ok, pair[1])
+		}
+	}
+
+	update := EscapeUpdate(contracts.DeviceDefinitionUpdateInput{Id: in.Id, Metadata: in.Metadata, DeviceType: in.DeviceType, ImageURI: in.ImageURI})
+	if err := testValidator.ValidateUpdate(update); err != nil {
+		t.Fatal(err)
+	}
+	if update.Id != escaped.Id || update.Metadata != escaped.Metadata {
+		t.Errorf("EscapeUpdate = %+v, want the fields of Escape", update)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	err := testValidator.Validate(contracts.DeviceDefinitionInput{Id: "a'", Model: "m", Year: big.NewInt(2022)})
+	want := "devicedefinition: invalid device definition: Id: unescaped single quote, see Escape"
+	if err == nil || err.Error() != want {
+		t.Fatalf("error = %v, want %s", err, want)
+	}
+	var field *FieldError
+	if !errors.As(err, &field) || field.Field != "Id" {
+		t.Errorf("errors.As(*FieldError) = %v", field)
+	}
+	if errs := err.(Errors); errs.Field("Id") == nil || errs.Field("Model") != nil {
+		t.Errorf("Field = %v, %v", errs.Field("Id"), errs.Field("Model"))
+	}
+}
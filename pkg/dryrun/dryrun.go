@@ -0,0 +1,255 @@
+// Package dryrun simulates DIMORegistry writes without sending them, to learn
+// whether they would succeed and which events they would emit.
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+// simulationGasLimit only keeps bind from estimating gas, the simulation uses
+// the block gas limit.
+const simulationGasLimit = 30_000_000
+
+// ErrReverted is the Result error of a reverted call whose revert data is
+// empty.
+var ErrReverted = errors.New("dryrun: execution reverted")
+
+// RPC is the JSON-RPC access needed by a Simulator. *rpc.Client satisfies it,
+// and ethclient.Client exposes one through its Client method.
+type RPC interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Result is the outcome of a simulated call. Err is the decoded registry
+// error if the call reverted, in which case there are no logs. Events holds
+// the registry logs decoded with RegistryFilterer.ParseLog, in log order.
+// Logs is nil when the node has no eth_simulateV1 and the call was run with
+// eth_call.
+type Result struct {
+	ReturnData []byte
+	GasUsed    uint64
+	Logs       []types.Log
+	Events     []contracts.RegistryEvent
+	Err        error
+}
+
+// Simulator runs registry writes as simulated calls.
+type Simulator struct {
+	rpc        RPC
+	address    common.Address
+	filterer   *contracts.RegistryFilterer
+	transactor *contracts.RegistryTransactor
+}
+
+// New returns a Simulator for the registry deployed at address.
+func New(client RPC, address common.Address) (*Simulator, error) {
+	filterer, err := contracts.NewRegistryFilterer(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	transactor, err := contracts.NewRegistryTransactor(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Simulator{rpc: client, address: address, filterer: filterer, transactor: transactor}, nil
+}
+
+// Opts returns transaction options that make a generated RegistryTransactor
+// method build its transaction from from without touching the chain or
+// sending it. The transaction is not signed and is only meant for
+// SimulateTx.
+func Opts(from common.Address) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:     from,
+		Nonce:    new(big.Int),
+		GasPrice: new(big.Int),
+		GasLimit: simulationGasLimit,
+		NoSend:   true,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+	}
+}
+
+// Transactor returns the registry bindings to build transactions with Opts,
+// e.g.
+//
+//	tx, err := sim.Transactor().AdminChangeParentNode(dryrun.Opts(admin), parent, proxy, ids)
+//	res, err := sim.SimulateTx(ctx, admin, tx, nil)
+func (s *Simulator) Transactor() *contracts.RegistryTransactor {
+	return s.transactor
+}
+
+// SimulateTx simulates tx sent by from on top of block, or the latest block
+// if block is nil. Only the recipient, value and data of tx are used.
+func (s *Simulator) SimulateTx(ctx context.Context, from common.Address, tx *types.Transaction, block *big.Int) (*Result, error) {
+	return s.simulate(ctx, ethereum.CallMsg{From: from, To: tx.To(), Value: tx.Value(), Data: tx.Data()}, block)
+}
+
+// Simulate packs a call to the registry method and simulates it, see
+// SimulateTx. method is either a full signature, such as
+// "mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[])",
+// or the name of the generated binding with a lowercase first letter.
+// Overloads of a Solidity function get a numeric suffix in the bindings,
+// e.g. mintVehicleWithDeviceDefinition0 and pairAftermarketDeviceSign0, so
+// "mintVehicleWithDeviceDefinition" is only one of them.
+func (s *Simulator) Simulate(ctx context.Context, from common.Address, block *big.Int, method string, args ...interface{}) (*Result, error) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	name := method
+	if strings.Contains(method, "(") {
+		name = ""
+		for key, m := range parsed.Methods {
+			if m.Sig == method {
+				name = key
+				break
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("dryrun: no registry method %s", method)
+		}
+	}
+	data, err := parsed.Pack(name, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+	return s.simulate(ctx, ethereum.CallMsg{From: from, To: &s.address, Data: data}, block)
+}
+
+type simulateCall struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+	Data  hexutil.Bytes   `json:"input"`
+}
+
+type simulateBlock struct {
+	Calls []simulateCall `json:"calls"`
+}
+
+type simulateOpts struct {
+	BlockStateCalls []simulateBlock `json:"blockStateCalls"`
+}
+
+type simulatedBlock struct {
+	Calls []simulatedCall `json:"calls"`
+}
+
+type simulatedCall struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []types.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *struct {
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
+
+func (s *Simulator) simulate(ctx context.Context, msg ethereum.CallMsg, block *big.Int) (*Result, error) {
+	call := simulateCall{From: msg.From, To: msg.To, Data: msg.Data}
+	if msg.Value != nil {
+		call.Value = (*hexutil.Big)(msg.Value)
+	}
+
+	var blocks []simulatedBlock
+	opts := simulateOpts{BlockStateCalls: []simulateBlock{{Calls: []simulateCall{call}}}}
+	err := s.rpc.CallContext(ctx, &blocks, "eth_simulateV1", opts, blockArg(block))
+	if isUnsupported(err) {
+		return s.call(ctx, call, block)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != 1 {
+		return nil, fmt.Errorf("dryrun: unexpected eth_simulateV1 result")
+	}
+
+	sim := blocks[0].Calls[0]
+	res := &Result{ReturnData: sim.ReturnData, GasUsed: uint64(sim.GasUsed)}
+	if sim.Status != hexutil.Uint64(types.ReceiptStatusSuccessful) {
+		res.Err = ErrReverted
+		if sim.Error != nil {
+			if data, err := hexutil.Decode(sim.Error.Data); err == nil && len(data) > 0 {
+				res.Err = registryerrors.Decode(data)
+			} else if sim.Error.Message != "" {
+				res.Err = fmt.Errorf("%w: %s", ErrReverted, sim.Error.Message)
+			}
+		}
+		return res, nil
+	}
+
+	res.Logs = sim.Logs
+	for _, log := range sim.Logs {
+		if log.Address != s.address {
+			continue
+		}
+		ev, err := s.filterer.ParseLog(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d: %w", log.Index, err)
+		}
+		res.Events = append(res.Events, ev)
+	}
+	return res, nil
+}
+
+// call is the eth_call fallback for nodes without eth_simulateV1. It tells
+// whether the call succeeds but cannot return logs.
+func (s *Simulator) call(ctx context.Context, call simulateCall, block *big.Int) (*Result, error) {
+	var out hexutil.Bytes
+	err := s.rpc.CallContext(ctx, &out, "eth_call", call, blockArg(block))
+	if err != nil {
+		var dataErr rpc.DataError
+		if !errors.As(err, &dataErr) {
+			return nil, err
+		}
+		decoded := registryerrors.FromError(err)
+		if decoded == err {
+			decoded = fmt.Errorf("%w: %v", ErrReverted, err)
+		}
+		return &Result{Err: decoded}, nil
+	}
+	return &Result{ReturnData: out}, nil
+}
+
+// isUnsupported reports whether err is how nodes without eth_simulateV1
+// answer it: method not found, or an invalid request or params from nodes
+// that do not know the method's arguments.
+func isUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case -32601, -32600, -32602:
+			return true
+		}
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "method not found") ||
+		strings.Contains(msg, "does not exist/is not available")
+}
+
+func blockArg(block *big.Int) string {
+	if block == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(block)
+}
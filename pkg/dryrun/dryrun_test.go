@@ -0,0 +1,384 @@
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+var (
+	testRegistry = common.HexToAddress("0x5eAA351EdFc8bCe8fA9DEa2fEdDD5A84cDAde96c")
+	testFrom     = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+)
+
+// unsetVehicleStream7 is the calldata of unsetVehicleStream(7).
+const unsetVehicleStream7 = "0xcd90df7e0000000000000000000000000000000000000000000000000000000000000007"
+
+// fakeRPC answers each method with a JSON result or an error and records the
+// requests.
+type fakeRPC struct {
+	results map[string]string
+	errs    map[string]error
+	calls   []string
+	params  []string
+}
+
+func (r *fakeRPC) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	params, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	r.calls = append(r.calls, method)
+	r.params = append(r.params, string(params))
+	if err := r.errs[method]; err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(r.results[method]), result)
+}
+
+// rpcError is a JSON-RPC error with a code, and revert data if set.
+type rpcError struct {
+	code int
+	data interface{}
+}
+
+func (e *rpcError) Error() string          { return "rpc error" }
+func (e *rpcError) ErrorCode() int         { return e.code }
+func (e *rpcError) ErrorData() interface{} { return e.data }
+
+var errMethodNotFound = &rpcError{code: -32601}
+
+func newSimulator(t *testing.T, rpc *fakeRPC) *Simulator {
+	t.Helper()
+	sim, err := New(rpc, testRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sim
+}
+
+// simulated returns an eth_simulateV1 result with one call.
+func simulated(t *testing.T, call map[string]interface{}) string {
+	t.Helper()
+	out, err := json.Marshal([]map[string]interface{}{{"calls": []interface{}{call}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func mintedLog(t *testing.T, address common.Address, index uint) map[string]interface{} {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics, data := simtest.EventLog(t, parsed.Events["VehicleNodeMinted"], big.NewInt(137), big.NewInt(7), testFrom)
+	return map[string]interface{}{
+		"address":          address,
+		"topics":           topics,
+		"data":             hexutil.Bytes(data),
+		"blockNumber":      "0x10",
+		"transactionHash":  common.Hash{1},
+		"transactionIndex": "0x0",
+		"blockHash":        common.Hash{2},
+		"logIndex":         hexutil.Uint(index),
+		"removed":          false,
+	}
+}
+
+func TestSimulateRequest(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		block *big.Int
+		want  string
+	}{
+		{"latest", nil, `[{"blockStateCalls":[{"calls":[{"from":"0x00000000000000000000000000000000000000a1","to":"0x5eaa351edfc8bce8fa9dea2feddd5a84cdade96c","input":"` + unsetVehicleStream7 + `"}]}]},"latest"]`},
+		{"block", big.NewInt(300), `[{"blockStateCalls":[{"calls":[{"from":"0x00000000000000000000000000000000000000a1","to":"0x5eaa351edfc8bce8fa9dea2feddd5a84cdade96c","input":"` + unsetVehicleStream7 + `"}]}]},"0x12c"]`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &fakeRPC{results: map[string]string{"eth_simulateV1": simulated(t, map[string]interface{}{"status": "0x1", "returnData": "0x", "gasUsed": "0x5208", "logs": []interface{}{}})}}
+			sim := newSimulator(t, rpc)
+			if _, err := sim.Simulate(context.Background(), testFrom, tt.block, "unsetVehicleStream", big.NewInt(7)); err != nil {
+				t.Fatal(err)
+			}
+			if got := rpc.params[0]; got != tt.want {
+				t.Errorf("params =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateOverloaded(t *testing.T) {
+	const signature = "mintVehicleWithDeviceDefinition(uint256,address,string,(string,string)[])"
+	args := []interface{}{big.NewInt(137), testFrom, "ford_bronco_2022", []contracts.AttributeInfoPair{{Attribute: "Make", Info: "Ford"}}}
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Methods["mintVehicleWithDeviceDefinition2"].Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := hexutil.Encode(append(crypto.Keccak256([]byte(signature))[:4], data...))
+
+	for _, method := range []string{signature, "mintVehicleWithDeviceDefinition2"} {
+		t.Run(method, func(t *testing.T) {
+			rpc := &fakeRPC{results: map[string]string{"eth_simulateV1": simulated(t, map[string]interface{}{"status": "0x1", "returnData": "0x", "gasUsed": "0x0", "logs": []interface{}{}})}}
+			sim := newSimulator(t, rpc)
+			if _, err := sim.Simulate(context.Background(), testFrom, nil, method, args...); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(rpc.params[0], `"input":"`+want+`"`) {
+				t.Errorf("params = %s, want the input %s", rpc.params[0], want)
+			}
+		})
+	}
+
+	sim := newSimulator(t, &fakeRPC{})
+	if _, err := sim.Simulate(context.Background(), testFrom, nil, "mintVehicleWithDeviceDefinition(uint256)", big.NewInt(137)); err == nil {
+		t.Error("Simulate() of an unknown signature succeeded, want an error")
+	}
+}
+
+func TestSimulateTx(t *testing.T) {
+	rpc := &fakeRPC{results: map[string]string{"eth_simulateV1": simulated(t, map[string]interface{}{"status": "0x1", "returnData": "0x", "gasUsed": "0x0", "logs": []interface{}{}})}}
+	sim := newSimulator(t, rpc)
+
+	tx, err := sim.Transactor().UnsetVehicleStream(Opts(testFrom), big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sim.SimulateTx(context.Background(), testFrom, tx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(rpc.params[0], `"input":"`+unsetVehicleStream7+`"`) {
+		t.Errorf("params = %s, want the input %s", rpc.params[0], unsetVehicleStream7)
+	}
+}
+
+func TestSimulateResult(t *testing.T) {
+	invalidNode, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	revertData, err := invalidNode.Errors["InvalidNode"].Inputs.Pack(testRegistry, big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	revertData = append(invalidNode.Errors["InvalidNode"].ID.Bytes()[:4], revertData...)
+
+	other := common.HexToAddress("0x0b")
+	for _, tt := range []struct {
+		name       string
+		call       map[string]interface{}
+		wantEvents []string
+		wantLogs   int
+		check      func(t *testing.T, err error)
+	}{
+		{
+			name: "events",
+			call: map[string]interface{}{
+				"status": "0x1", "returnData": "0x2a", "gasUsed": "0x5208",
+				"logs": []interface{}{mintedLog(t, testRegistry, 0), mintedLog(t, other, 1), mintedLog(t, testRegistry, 2)},
+			},
+			wantEvents: []string{"VehicleNodeMinted", "VehicleNodeMinted"},
+			wantLogs:   3,
+		},
+		{
+			name: "registry error",
+			call: map[string]interface{}{
+				"status": "0x0", "returnData": "0x", "gasUsed": "0x5208", "logs": []interface{}{},
+				"error": map[string]interface{}{"message": "execution reverted", "data": hexutil.Encode(revertData)},
+			},
+			check: func(t *testing.T, err error) {
+				var target *registryerrors.InvalidNode
+				if !errors.As(err, &target) || target.TokenID.Int64() != 7 {
+					t.Errorf("Err = %v, want InvalidNode of 7", err)
+				}
+			},
+		},
+		{
+			name: "message",
+			call: map[string]interface{}{
+				"status": "0x0", "returnData": "0x", "gasUsed": "0x5208", "logs": []interface{}{},
+				"error": map[string]interface{}{"message": "out of gas", "data": "0x"},
+			},
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, ErrReverted) || !strings.Contains(err.Error(), "out of gas") {
+					t.Errorf("Err = %v, want %v with the message", err, ErrReverted)
+				}
+			},
+		},
+		{
+			name: "no error",
+			call: map[string]interface{}{"status": "0x0", "returnData": "0x", "gasUsed": "0x5208", "logs": []interface{}{}},
+			check: func(t *testing.T, err error) {
+				if err != ErrReverted {
+					t.Errorf("Err = %v, want %v", err, ErrReverted)
+				}
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := newSimulator(t, &fakeRPC{results: map[string]string{"eth_simulateV1": simulated(t, tt.call)}})
+			res, err := sim.Simulate(context.Background(), testFrom, nil, "unsetVehicleStream", big.NewInt(7))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.GasUsed != 21000 {
+				t.Errorf("GasUsed = %d, want 21000", res.GasUsed)
+			}
+			if tt.check != nil {
+				tt.check(t, res.Err)
+				if res.Logs != nil || res.Events != nil {
+					t.Errorf("reverted call has logs %v and events %v", res.Logs, res.Events)
+				}
+				return
+			}
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			if len(res.Logs) != tt.wantLogs {
+				t.Errorf("%d logs, want %d", len(res.Logs), tt.wantLogs)
+			}
+			var names []string
+			for _, ev := range res.Events {
+				names = append(names, ev.EventName())
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantEvents, ",") {
+				t.Errorf("events = %v, want %v", names, tt.wantEvents)
+			}
+			if minted, ok := res.Events[1].(*contracts.RegistryVehicleNodeMinted); !ok || minted.TokenId.Int64() != 7 || minted.Raw.Index != 2 {
+				t.Errorf("second event = %+v, want token 7 from log 2", res.Events[1])
+			}
+			if hexutil.Encode(res.ReturnData) != "0x2a" {
+				t.Errorf("ReturnData = %x, want 2a", res.ReturnData)
+			}
+		})
+	}
+}
+
+func TestSimulateMalformed(t *testing.T) {
+	badLog := mintedLog(t, testRegistry, 0)
+	badLog["data"] = "0x01"
+	for name, result := range map[string]string{
+		"no blocks":        `[]`,
+		"two calls":        `[{"calls":[{"status":"0x1"},{"status":"0x1"}]}]`,
+		"bad registry log": simulated(t, map[string]interface{}{"status": "0x1", "returnData": "0x", "gasUsed": "0x0", "logs": []interface{}{badLog}}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			sim := newSimulator(t, &fakeRPC{results: map[string]string{"eth_simulateV1": result}})
+			if _, err := sim.Simulate(context.Background(), testFrom, nil, "unsetVehicleStream", big.NewInt(7)); err == nil {
+				t.Fatal("Simulate() succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestSimulateUnsupported(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"method not found", errMethodNotFound, true},
+		{"invalid request", &rpcError{code: -32600}, true},
+		{"invalid params", &rpcError{code: -32602}, true},
+		{"message", errors.New("Method not found"), true},
+		{"geth message", errors.New("the method eth_simulateV1 does not exist/is not available"), true},
+		{"server error", &rpcError{code: -32000}, false},
+		{"transport error", errors.New("connection refused"), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &fakeRPC{
+				results: map[string]string{"eth_call": `"0x"`},
+				errs:    map[string]error{"eth_simulateV1": tt.err},
+			}
+			sim := newSimulator(t, rpc)
+			_, err := sim.Simulate(context.Background(), testFrom, nil, "unsetVehicleStream", big.NewInt(7))
+			if fellBack := strings.Join(rpc.calls, ",") == "eth_simulateV1,eth_call"; fellBack != tt.want {
+				t.Errorf("calls = %v, error %v, want a fallback to eth_call: %t", rpc.calls, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateFallback(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		result   string
+		err      error
+		wantErr  error
+		wantData string
+		check    func(t *testing.T, err error)
+	}{
+		{name: "success", result: `"0x2a"`, wantData: "0x2a"},
+		{
+			name: "revert",
+			err:  &rpcError{code: 3, data: "0x"},
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, ErrReverted) {
+					t.Errorf("Err = %v, want %v", err, ErrReverted)
+				}
+			},
+		},
+		{
+			name: "registry error",
+			err:  &rpcError{code: 3, data: "0x08c379a0" + strings.Repeat("0", 62) + "20" + strings.Repeat("0", 62) + "02" + "6e6f" + strings.Repeat("0", 60)},
+			check: func(t *testing.T, err error) {
+				var target *registryerrors.Revert
+				if !errors.As(err, &target) || target.Reason != "no" {
+					t.Errorf("Err = %v, want Revert(no)", err)
+				}
+			},
+		},
+		{name: "transport error", err: errors.New("connection refused"), wantErr: errors.New("connection refused")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rpc := &fakeRPC{
+				results: map[string]string{"eth_call": tt.result},
+				errs:    map[string]error{"eth_simulateV1": errMethodNotFound, "eth_call": tt.err},
+			}
+			sim := newSimulator(t, rpc)
+			res, err := sim.Simulate(context.Background(), testFrom, big.NewInt(300), "unsetVehicleStream", big.NewInt(7))
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("Simulate() = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(rpc.calls, ","); got != "eth_simulateV1,eth_call" {
+				t.Errorf("calls = %s, want eth_simulateV1,eth_call", got)
+			}
+			want := `[{"from":"0x00000000000000000000000000000000000000a1","to":"0x5eaa351edfc8bce8fa9dea2feddd5a84cdade96c","input":"` + unsetVehicleStream7 + `"},"0x12c"]`
+			if got := rpc.params[1]; got != want {
+				t.Errorf("eth_call params =\n%s\nwant\n%s", got, want)
+			}
+			if res.Logs != nil {
+				t.Errorf("Logs = %v, want nil without eth_simulateV1", res.Logs)
+			}
+			if tt.check != nil {
+				tt.check(t, res.Err)
+				return
+			}
+			if res.Err != nil || hexutil.Encode(res.ReturnData) != tt.wantData {
+				t.Errorf("Simulate() = %x, %v, want %s", res.ReturnData, res.Err, tt.wantData)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package eip712 builds and signs the EIP-712 typed data verified by the
+// DIMORegistry Eip712Checker module.
+package eip712
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// DefaultDomainName is the domain name the registry is initialized with.
+	DefaultDomainName = "DIMO"
+	// DefaultDomainVersion is the domain version the registry is initialized with.
+	DefaultDomainVersion = "1"
+)
+
+// DomainTypeHash is keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)").
+var DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+
+// Domain is the EIP-712 domain of a DIMORegistry deployment.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+}
+
+// NewDomain returns the default DIMO domain for the registry deployed at
+// verifyingContract on the given chain.
+func NewDomain(chainID *big.Int, verifyingContract common.Address) Domain {
+	return Domain{
+		Name:              DefaultDomainName,
+		Version:           DefaultDomainVersion,
+		ChainID:           chainID,
+		VerifyingContract: verifyingContract,
+	}
+}
+
+// Separator returns the domain separator, as computed by
+// Eip712CheckerInternal._eip712Domain.
+func (d Domain) Separator() common.Hash {
+	return crypto.Keccak256Hash(
+		DomainTypeHash[:],
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		encodeUint(d.ChainID),
+		encodeAddress(d.VerifyingContract),
+	)
+}
+
+// Message is a typed payload verified by the registry.
+type Message interface {
+	// PrimaryType returns the EIP-712 type name, e.g. "BurnVehicleSign".
+	PrimaryType() string
+	// TypeHash returns keccak256 of the encoded type.
+	TypeHash() common.Hash
+	// StructHash returns hashStruct(message).
+	StructHash() common.Hash
+	// Types returns the fields of the primary type.
+	Types() []apitypes.Type
+	// Values returns the message in the form expected by apitypes.TypedData.
+	Values() apitypes.TypedDataMessage
+}
+
+// Hash returns the digest that is signed and later recovered by
+// Eip712CheckerInternal._verifySignature: keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+func Hash(d Domain, m Message) common.Hash {
+	sep := d.Separator()
+	structHash := m.StructHash()
+	return crypto.Keccak256Hash([]byte("\x19\x01"), sep[:], structHash[:])
+}
+
+// Sign signs the message with key and returns a 65 byte [R || S || V]
+// signature with V set to 27 or 28, as expected by the registry.
+func Sign(key *ecdsa.PrivateKey, d Domain, m Message) ([]byte, error) {
+	hash := Hash(d, m)
+	sig, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// TypedData returns the message as apitypes.TypedData, suitable for
+// eth_signTypedData_v4 requests to wallets.
+func TypedData(d Domain, m Message) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			m.PrimaryType(): m.Types(),
+		},
+		PrimaryType: m.PrimaryType(),
+		Domain: apitypes.TypedDataDomain{
+			Name:              d.Name,
+			Version:           d.Version,
+			ChainId:           (*math.HexOrDecimal256)(d.ChainID),
+			VerifyingContract: d.VerifyingContract.Hex(),
+		},
+		Message: m.Values(),
+	}
+}
+
+func encodeUint(v *big.Int) []byte {
+	if v == nil {
+		return make([]byte, 32)
+	}
+	return math.U256Bytes(new(big.Int).Set(v))
+}
+
+func encodeAddress(a common.Address) []byte {
+	return common.LeftPadBytes(a[:], 32)
+}
+
+func encodeString(s string) []byte {
+	return crypto.Keccak256([]byte(s))
+}
+
+// encodeStringArray encodes a string[] member as keccak256 of the
+// concatenated keccak256 hashes of its elements.
+func encodeStringArray(ss []string) []byte {
+	hashes := make([][]byte, len(ss))
+	for i, s := range ss {
+		hashes[i] = encodeString(s)
+	}
+	return crypto.Keccak256(hashes...)
+}
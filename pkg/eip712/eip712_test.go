@@ -0,0 +1,141 @@
+package eip712
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var testDomain = NewDomain(big.NewInt(80002), common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c"))
+
+// testMessages covers every message type, with the type string each one is
+// hashed from in the contracts.
+var testMessages = []struct {
+	encodedType string
+	msg         Message
+}{
+	{
+		"MintVehicleSign(uint256 manufacturerNode,address owner,string[] attributes,string[] infos)",
+		NewMintVehicleSign(big.NewInt(137), common.HexToAddress("0x1"), []contracts.AttributeInfoPair{
+			{Attribute: "Make", Info: "Ford"},
+			{Attribute: "Model", Info: "Bronco"},
+		}),
+	},
+	{
+		"MintVehicleWithDeviceDefinitionSign(uint256 manufacturerNode,address owner,string deviceDefinitionId,string[] attributes,string[] infos)",
+		NewMintVehicleWithDeviceDefinitionSign(big.NewInt(137), common.HexToAddress("0x1"), "ford_bronco_2022", []contracts.AttributeInfoPair{
+			{Attribute: "Year", Info: "2022"},
+		}),
+	},
+	{
+		"MintVehicleWithDeviceDefinitionSign(uint256 manufacturerNode,address owner,string deviceDefinitionId,string[] attributes,string[] infos)",
+		NewMintVehicleWithDeviceDefinitionSign(big.NewInt(1), common.HexToAddress("0x2"), "", nil),
+	},
+	{
+		"BurnVehicleSign(uint256 vehicleNode)",
+		&BurnVehicleSign{VehicleNode: big.NewInt(42)},
+	},
+	{
+		"ClaimAftermarketDeviceSign(uint256 aftermarketDeviceNode,address owner)",
+		&ClaimAftermarketDeviceSign{AftermarketDeviceNode: big.NewInt(7), Owner: common.HexToAddress("0x3")},
+	},
+	{
+		"PairAftermarketDeviceSign(uint256 aftermarketDeviceNode,uint256 vehicleNode)",
+		&PairAftermarketDeviceSign{AftermarketDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(42)},
+	},
+	{
+		"UnPairAftermarketDeviceSign(uint256 aftermarketDeviceNode,uint256 vehicleNode)",
+		&UnPairAftermarketDeviceSign{AftermarketDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(42)},
+	},
+	{
+		"MintSyntheticDeviceSign(uint256 connectionId,uint256 vehicleNode)",
+		&MintSyntheticDeviceSign{ConnectionId: big.NewInt(3), VehicleNode: big.NewInt(42)},
+	},
+	{
+		"BurnSyntheticDeviceSign(uint256 vehicleNode,uint256 syntheticDeviceNode)",
+		&BurnSyntheticDeviceSign{VehicleNode: big.NewInt(42), SyntheticDeviceNode: big.NewInt(9)},
+	},
+	{
+		"MintVehicleAndSdSign(uint256 connectionId)",
+		&MintVehicleAndSdSign{ConnectionId: new(big.Int).Lsh(big.NewInt(1), 255)},
+	},
+}
+
+func TestTypeHash(t *testing.T) {
+	for _, tt := range testMessages {
+		t.Run(tt.msg.PrimaryType(), func(t *testing.T) {
+			want := crypto.Keccak256Hash([]byte(tt.encodedType))
+			if got := tt.msg.TypeHash(); got != want {
+				t.Errorf("TypeHash() = %s, want keccak256(%q) = %s", got, tt.encodedType, want)
+			}
+
+			td := TypedData(testDomain, tt.msg)
+			if got := common.BytesToHash(td.TypeHash(tt.msg.PrimaryType())); got != want {
+				t.Errorf("Types() hash to %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDomainSeparator(t *testing.T) {
+	td := TypedData(testDomain, &BurnVehicleSign{VehicleNode: big.NewInt(1)})
+	want, err := td.HashStruct("EIP712Domain", td.Domain.Map())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testDomain.Separator(); got != common.BytesToHash(want) {
+		t.Errorf("Separator() = %s, want %s", got, common.BytesToHash(want))
+	}
+}
+
+// TestHash checks Hash against the reference eth_signTypedData_v4
+// implementation of go-ethereum.
+func TestHash(t *testing.T) {
+	for _, tt := range testMessages {
+		t.Run(tt.msg.PrimaryType(), func(t *testing.T) {
+			want, _, err := apitypes.TypedDataAndHash(TypedData(testDomain, tt.msg))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := Hash(testDomain, tt.msg); got != common.BytesToHash(want) {
+				t.Errorf("Hash() = %s, want %s", got, common.BytesToHash(want))
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := crypto.PubkeyToAddress(key.PublicKey)
+
+	for _, tt := range testMessages {
+		t.Run(tt.msg.PrimaryType(), func(t *testing.T) {
+			sig, err := Sign(key, testDomain, tt.msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v := sig[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+				t.Fatalf("V = %d, want 27 or 28", v)
+			}
+
+			normalized := append([]byte(nil), sig...)
+			normalized[crypto.RecoveryIDOffset] -= 27
+			hash := Hash(testDomain, tt.msg)
+			pub, err := crypto.SigToPub(hash[:], normalized)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := crypto.PubkeyToAddress(*pub); got != want {
+				t.Errorf("recovered %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package eip712
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// Type hashes of every message verified by the registry.
+var (
+	MintVehicleSignTypeHash                     = crypto.Keccak256Hash([]byte("MintVehicleSign(uint256 manufacturerNode,address owner,string[] attributes,string[] infos)"))
+	MintVehicleWithDeviceDefinitionSignTypeHash = crypto.Keccak256Hash([]byte("MintVehicleWithDeviceDefinitionSign(uint256 manufacturerNode,address owner,string deviceDefinitionId,string[] attributes,string[] infos)"))
+	BurnVehicleSignTypeHash                     = crypto.Keccak256Hash([]byte("BurnVehicleSign(uint256 vehicleNode)"))
+	ClaimAftermarketDeviceSignTypeHash          = crypto.Keccak256Hash([]byte("ClaimAftermarketDeviceSign(uint256 aftermarketDeviceNode,address owner)"))
+	PairAftermarketDeviceSignTypeHash           = crypto.Keccak256Hash([]byte("PairAftermarketDeviceSign(uint256 aftermarketDeviceNode,uint256 vehicleNode)"))
+	UnPairAftermarketDeviceSignTypeHash         = crypto.Keccak256Hash([]byte("UnPairAftermarketDeviceSign(uint256 aftermarketDeviceNode,uint256 vehicleNode)"))
+	MintSyntheticDeviceSignTypeHash             = crypto.Keccak256Hash([]byte("MintSyntheticDeviceSign(uint256 connectionId,uint256 vehicleNode)"))
+	BurnSyntheticDeviceSignTypeHash             = crypto.Keccak256Hash([]byte("BurnSyntheticDeviceSign(uint256 vehicleNode,uint256 syntheticDeviceNode)"))
+	MintVehicleAndSdSignTypeHash                = crypto.Keccak256Hash([]byte("MintVehicleAndSdSign(uint256 connectionId)"))
+)
+
+// SplitAttrInfoPairs splits attribute-info pairs into the parallel
+// attributes and infos lists that vehicle mint messages sign over.
+func SplitAttrInfoPairs(pairs []contracts.AttributeInfoPair) (attributes []string, infos []string) {
+	attributes = make([]string, len(pairs))
+	infos = make([]string, len(pairs))
+	for i, p := range pairs {
+		attributes[i] = p.Attribute
+		infos[i] = p.Info
+	}
+	return attributes, infos
+}
+
+// MintVehicleSign is signed by the vehicle owner in MintVehicleAndSdSign.
+type MintVehicleSign struct {
+	ManufacturerNode *big.Int
+	Owner            common.Address
+	Attributes       []string
+	Infos            []string
+}
+
+// NewMintVehicleSign builds a MintVehicleSign from the attribute-info pairs
+// that will be passed to the registry.
+func NewMintVehicleSign(manufacturerNode *big.Int, owner common.Address, attrInfo []contracts.AttributeInfoPair) *MintVehicleSign {
+	attributes, infos := SplitAttrInfoPairs(attrInfo)
+	return &MintVehicleSign{
+		ManufacturerNode: manufacturerNode,
+		Owner:            owner,
+		Attributes:       attributes,
+		Infos:            infos,
+	}
+}
+
+func (m *MintVehicleSign) PrimaryType() string   { return "MintVehicleSign" }
+func (m *MintVehicleSign) TypeHash() common.Hash { return MintVehicleSignTypeHash }
+
+func (m *MintVehicleSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		MintVehicleSignTypeHash[:],
+		encodeUint(m.ManufacturerNode),
+		encodeAddress(m.Owner),
+		encodeStringArray(m.Attributes),
+		encodeStringArray(m.Infos),
+	)
+}
+
+func (m *MintVehicleSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "manufacturerNode", Type: "uint256"},
+		{Name: "owner", Type: "address"},
+		{Name: "attributes", Type: "string[]"},
+		{Name: "infos", Type: "string[]"},
+	}
+}
+
+func (m *MintVehicleSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"manufacturerNode": uintValue(m.ManufacturerNode),
+		"owner":            m.Owner.Hex(),
+		"attributes":       stringArrayValue(m.Attributes),
+		"infos":            stringArrayValue(m.Infos),
+	}
+}
+
+// MintVehicleWithDeviceDefinitionSign is signed by the vehicle owner in
+// MintVehicleWithDeviceDefinitionSign and MintVehicleAndSdWithDeviceDefinitionSign.
+type MintVehicleWithDeviceDefinitionSign struct {
+	ManufacturerNode   *big.Int
+	Owner              common.Address
+	DeviceDefinitionId string
+	Attributes         []string
+	Infos              []string
+}
+
+// NewMintVehicleWithDeviceDefinitionSign builds a
+// MintVehicleWithDeviceDefinitionSign from the attribute-info pairs that will
+// be passed to the registry.
+func NewMintVehicleWithDeviceDefinitionSign(manufacturerNode *big.Int, owner common.Address, deviceDefinitionId string, attrInfo []contracts.AttributeInfoPair) *MintVehicleWithDeviceDefinitionSign {
+	attributes, infos := SplitAttrInfoPairs(attrInfo)
+	return &MintVehicleWithDeviceDefinitionSign{
+		ManufacturerNode:   manufacturerNode,
+		Owner:              owner,
+		DeviceDefinitionId: deviceDefinitionId,
+		Attributes:         attributes,
+		Infos:              infos,
+	}
+}
+
+func (m *MintVehicleWithDeviceDefinitionSign) PrimaryType() string {
+	return "MintVehicleWithDeviceDefinitionSign"
+}
+
+func (m *MintVehicleWithDeviceDefinitionSign) TypeHash() common.Hash {
+	return MintVehicleWithDeviceDefinitionSignTypeHash
+}
+
+func (m *MintVehicleWithDeviceDefinitionSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		MintVehicleWithDeviceDefinitionSignTypeHash[:],
+		encodeUint(m.ManufacturerNode),
+		encodeAddress(m.Owner),
+		encodeString(m.DeviceDefinitionId),
+		encodeStringArray(m.Attributes),
+		encodeStringArray(m.Infos),
+	)
+}
+
+func (m *MintVehicleWithDeviceDefinitionSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "manufacturerNode", Type: "uint256"},
+		{Name: "owner", Type: "address"},
+		{Name: "deviceDefinitionId", Type: "string"},
+		{Name: "attributes", Type: "string[]"},
+		{Name: "infos", Type: "string[]"},
+	}
+}
+
+func (m *MintVehicleWithDeviceDefinitionSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"manufacturerNode":   uintValue(m.ManufacturerNode),
+		"owner":              m.Owner.Hex(),
+		"deviceDefinitionId": m.DeviceDefinitionId,
+		"attributes":         stringArrayValue(m.Attributes),
+		"infos":              stringArrayValue(m.Infos),
+	}
+}
+
+// BurnVehicleSign is signed by the vehicle owner in BurnVehicleSign.
+type BurnVehicleSign struct {
+	VehicleNode *big.Int
+}
+
+func (m *BurnVehicleSign) PrimaryType() string   { return "BurnVehicleSign" }
+func (m *BurnVehicleSign) TypeHash() common.Hash { return BurnVehicleSignTypeHash }
+
+func (m *BurnVehicleSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		BurnVehicleSignTypeHash[:],
+		encodeUint(m.VehicleNode),
+	)
+}
+
+func (m *BurnVehicleSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "vehicleNode", Type: "uint256"},
+	}
+}
+
+func (m *BurnVehicleSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"vehicleNode": uintValue(m.VehicleNode),
+	}
+}
+
+// ClaimAftermarketDeviceSign is signed by the new owner and by the
+// aftermarket device in ClaimAftermarketDeviceSign, and by the aftermarket
+// device alone in ClaimAftermarketDevice.
+type ClaimAftermarketDeviceSign struct {
+	AftermarketDeviceNode *big.Int
+	Owner                 common.Address
+}
+
+func (m *ClaimAftermarketDeviceSign) PrimaryType() string { return "ClaimAftermarketDeviceSign" }
+
+func (m *ClaimAftermarketDeviceSign) TypeHash() common.Hash {
+	return ClaimAftermarketDeviceSignTypeHash
+}
+
+func (m *ClaimAftermarketDeviceSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		ClaimAftermarketDeviceSignTypeHash[:],
+		encodeUint(m.AftermarketDeviceNode),
+		encodeAddress(m.Owner),
+	)
+}
+
+func (m *ClaimAftermarketDeviceSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "aftermarketDeviceNode", Type: "uint256"},
+		{Name: "owner", Type: "address"},
+	}
+}
+
+func (m *ClaimAftermarketDeviceSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"aftermarketDeviceNode": uintValue(m.AftermarketDeviceNode),
+		"owner":                 m.Owner.Hex(),
+	}
+}
+
+// PairAftermarketDeviceSign is signed by the aftermarket device and the
+// vehicle owner in PairAftermarketDeviceSign, or by the common owner of both
+// nodes in PairAftermarketDeviceSign0.
+type PairAftermarketDeviceSign struct {
+	AftermarketDeviceNode *big.Int
+	VehicleNode           *big.Int
+}
+
+func (m *PairAftermarketDeviceSign) PrimaryType() string { return "PairAftermarketDeviceSign" }
+
+func (m *PairAftermarketDeviceSign) TypeHash() common.Hash {
+	return PairAftermarketDeviceSignTypeHash
+}
+
+func (m *PairAftermarketDeviceSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		PairAftermarketDeviceSignTypeHash[:],
+		encodeUint(m.AftermarketDeviceNode),
+		encodeUint(m.VehicleNode),
+	)
+}
+
+func (m *PairAftermarketDeviceSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "aftermarketDeviceNode", Type: "uint256"},
+		{Name: "vehicleNode", Type: "uint256"},
+	}
+}
+
+func (m *PairAftermarketDeviceSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"aftermarketDeviceNode": uintValue(m.AftermarketDeviceNode),
+		"vehicleNode":           uintValue(m.VehicleNode),
+	}
+}
+
+// UnPairAftermarketDeviceSign is signed by either the aftermarket device owner
+// or the vehicle owner in UnpairAftermarketDeviceSign.
+type UnPairAftermarketDeviceSign struct {
+	AftermarketDeviceNode *big.Int
+	VehicleNode           *big.Int
+}
+
+func (m *UnPairAftermarketDeviceSign) PrimaryType() string { return "UnPairAftermarketDeviceSign" }
+
+func (m *UnPairAftermarketDeviceSign) TypeHash() common.Hash {
+	return UnPairAftermarketDeviceSignTypeHash
+}
+
+func (m *UnPairAftermarketDeviceSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		UnPairAftermarketDeviceSignTypeHash[:],
+		encodeUint(m.AftermarketDeviceNode),
+		encodeUint(m.VehicleNode),
+	)
+}
+
+func (m *UnPairAftermarketDeviceSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "aftermarketDeviceNode", Type: "uint256"},
+		{Name: "vehicleNode", Type: "uint256"},
+	}
+}
+
+func (m *UnPairAftermarketDeviceSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"aftermarketDeviceNode": uintValue(m.AftermarketDeviceNode),
+		"vehicleNode":           uintValue(m.VehicleNode),
+	}
+}
+
+// MintSyntheticDeviceSign is signed by the synthetic device and the vehicle
+// owner in MintSyntheticDeviceSign.
+type MintSyntheticDeviceSign struct {
+	ConnectionId *big.Int
+	VehicleNode  *big.Int
+}
+
+func (m *MintSyntheticDeviceSign) PrimaryType() string { return "MintSyntheticDeviceSign" }
+
+func (m *MintSyntheticDeviceSign) TypeHash() common.Hash {
+	return MintSyntheticDeviceSignTypeHash
+}
+
+func (m *MintSyntheticDeviceSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		MintSyntheticDeviceSignTypeHash[:],
+		encodeUint(m.ConnectionId),
+		encodeUint(m.VehicleNode),
+	)
+}
+
+func (m *MintSyntheticDeviceSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "connectionId", Type: "uint256"},
+		{Name: "vehicleNode", Type: "uint256"},
+	}
+}
+
+func (m *MintSyntheticDeviceSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"connectionId": uintValue(m.ConnectionId),
+		"vehicleNode":  uintValue(m.VehicleNode),
+	}
+}
+
+// BurnSyntheticDeviceSign is signed by the vehicle owner in
+// BurnSyntheticDeviceSign.
+type BurnSyntheticDeviceSign struct {
+	VehicleNode         *big.Int
+	SyntheticDeviceNode *big.Int
+}
+
+func (m *BurnSyntheticDeviceSign) PrimaryType() string { return "BurnSyntheticDeviceSign" }
+
+func (m *BurnSyntheticDeviceSign) TypeHash() common.Hash {
+	return BurnSyntheticDeviceSignTypeHash
+}
+
+func (m *BurnSyntheticDeviceSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		BurnSyntheticDeviceSignTypeHash[:],
+		encodeUint(m.VehicleNode),
+		encodeUint(m.SyntheticDeviceNode),
+	)
+}
+
+func (m *BurnSyntheticDeviceSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "vehicleNode", Type: "uint256"},
+		{Name: "syntheticDeviceNode", Type: "uint256"},
+	}
+}
+
+func (m *BurnSyntheticDeviceSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"vehicleNode":         uintValue(m.VehicleNode),
+		"syntheticDeviceNode": uintValue(m.SyntheticDeviceNode),
+	}
+}
+
+// MintVehicleAndSdSign is signed by the synthetic device in every
+// MintVehicleAndSd* method.
+type MintVehicleAndSdSign struct {
+	ConnectionId *big.Int
+}
+
+func (m *MintVehicleAndSdSign) PrimaryType() string   { return "MintVehicleAndSdSign" }
+func (m *MintVehicleAndSdSign) TypeHash() common.Hash { return MintVehicleAndSdSignTypeHash }
+
+func (m *MintVehicleAndSdSign) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		MintVehicleAndSdSignTypeHash[:],
+		encodeUint(m.ConnectionId),
+	)
+}
+
+func (m *MintVehicleAndSdSign) Types() []apitypes.Type {
+	return []apitypes.Type{
+		{Name: "connectionId", Type: "uint256"},
+	}
+}
+
+func (m *MintVehicleAndSdSign) Values() apitypes.TypedDataMessage {
+	return apitypes.TypedDataMessage{
+		"connectionId": uintValue(m.ConnectionId),
+	}
+}
+
+func uintValue(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
+
+func stringArrayValue(ss []string) []interface{} {
+	out := make([]interface{}, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
@@ -0,0 +1,316 @@
+package eip712
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// Errors returned while recovering a signature. They mirror the
+// RecoverError values of OpenZeppelin's ECDSA.tryRecover, except
+// ErrZeroSignatory, which matches the require of
+// Eip712CheckerInternal._verifySignature.
+var (
+	ErrZeroSignatory          = errors.New("eip712: zero signatory address")
+	ErrInvalidSignature       = errors.New("eip712: invalid signature")
+	ErrInvalidSignatureLength = errors.New("eip712: invalid signature length")
+	ErrInvalidSignatureS      = errors.New("eip712: invalid signature 's' value")
+	ErrSignerMismatch         = errors.New("eip712: signer does not match signatory")
+)
+
+// Errors returned by the operation checks of Verifier. Each one corresponds to
+// the custom error the registry reverts with for the same signature.
+var (
+	ErrInvalidOwnerSignature = errors.New("eip712: invalid owner signature")
+	ErrInvalidAdSignature    = errors.New("eip712: invalid aftermarket device signature")
+	ErrInvalidSdSignature    = errors.New("eip712: invalid synthetic device signature")
+	ErrInvalidSigner         = errors.New("eip712: invalid signer")
+)
+
+// erc1271MagicValue is bytes4(keccak256("isValidSignature(bytes32,bytes)")).
+var erc1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var erc1271Args = abi.Arguments{
+	{Type: mustNewType("bytes32")},
+	{Type: mustNewType("bytes")},
+}
+
+// secp256k1HalfN is the upper bound accepted by ECDSA.tryRecover for 's'.
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// Recover returns the address that produced sig over the message. Only
+// 65 byte signatures with V equal to 27 or 28 and a lower-half 's' are
+// accepted, as in ECDSA.tryRecover.
+func Recover(d Domain, m Message, sig []byte) (common.Address, error) {
+	return recoverHash(Hash(d, m), sig)
+}
+
+func recoverHash(hash common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignatureLength
+	}
+	if new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) > 0 {
+		return common.Address{}, ErrInvalidSignatureS
+	}
+	v := sig[crypto.RecoveryIDOffset]
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrInvalidSignature
+	}
+
+	normalized := make([]byte, crypto.SignatureLength)
+	copy(normalized, sig)
+	normalized[crypto.RecoveryIDOffset] -= 27
+
+	pub, err := crypto.SigToPub(hash[:], normalized)
+	if err != nil {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Verifier checks signatures the same way Eip712CheckerInternal._verifySignature
+// does. When constructed with a caller, signatures that fail ECDSA recovery are
+// also checked against signatory contracts through ERC-1271, matching
+// SignatureChecker.isValidSignatureNow.
+type Verifier struct {
+	domain Domain
+	caller bind.ContractCaller
+}
+
+// NewVerifier returns a Verifier for the domain. caller may be nil, in which
+// case only EOA signatures are accepted.
+func NewVerifier(d Domain, caller bind.ContractCaller) *Verifier {
+	return &Verifier{domain: d, caller: caller}
+}
+
+// Domain returns the domain the Verifier checks signatures against.
+func (v *Verifier) Domain() Domain {
+	return v.domain
+}
+
+// VerifySignature checks that sig is a valid signature of the message by
+// signatory.
+func (v *Verifier) VerifySignature(ctx context.Context, signatory common.Address, m Message, sig []byte) error {
+	if signatory == (common.Address{}) {
+		return ErrZeroSignatory
+	}
+
+	hash := Hash(v.domain, m)
+	signer, err := recoverHash(hash, sig)
+	if err == nil && signer == signatory {
+		return nil
+	}
+
+	if v.caller != nil {
+		ok, callErr := v.isValidERC1271Signature(ctx, signatory, hash, sig)
+		if callErr != nil {
+			return callErr
+		}
+		if ok {
+			return nil
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	return ErrSignerMismatch
+}
+
+func (v *Verifier) isValidERC1271Signature(ctx context.Context, signatory common.Address, hash common.Hash, sig []byte) (bool, error) {
+	args, err := erc1271Args.Pack(hash, sig)
+	if err != nil {
+		return false, err
+	}
+
+	out, err := v.caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &signatory,
+		Data: append(erc1271MagicValue[:], args...),
+	}, nil)
+	if err != nil {
+		// A reverting staticcall is treated as an invalid signature on-chain.
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(out) >= 32 && bytes.Equal(out[:32], common.RightPadBytes(erc1271MagicValue[:], 32)), nil
+}
+
+// VerifyMintVehicleWithDeviceDefinitionSign checks the owner signature passed
+// to MintVehicleWithDeviceDefinitionSign.
+func (v *Verifier) VerifyMintVehicleWithDeviceDefinitionSign(ctx context.Context, manufacturerNode *big.Int, owner common.Address, deviceDefinitionId string, attrInfo []contracts.AttributeInfoPair, signature []byte) error {
+	m := NewMintVehicleWithDeviceDefinitionSign(manufacturerNode, owner, deviceDefinitionId, attrInfo)
+	if err := v.VerifySignature(ctx, owner, m, signature); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// VerifyBurnVehicleSign checks the owner signature passed to BurnVehicleSign.
+func (v *Verifier) VerifyBurnVehicleSign(ctx context.Context, tokenId *big.Int, owner common.Address, ownerSig []byte) error {
+	if err := v.VerifySignature(ctx, owner, &BurnVehicleSign{VehicleNode: tokenId}, ownerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// VerifyClaimAftermarketDeviceSign checks the signatures passed to
+// ClaimAftermarketDeviceSign. aftermarketDeviceAddr is the address registered
+// for the aftermarket device node.
+func (v *Verifier) VerifyClaimAftermarketDeviceSign(ctx context.Context, aftermarketDeviceNode *big.Int, owner common.Address, aftermarketDeviceAddr common.Address, ownerSig []byte, aftermarketDeviceSig []byte) error {
+	m := &ClaimAftermarketDeviceSign{AftermarketDeviceNode: aftermarketDeviceNode, Owner: owner}
+	if err := v.VerifySignature(ctx, owner, m, ownerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	if err := v.VerifySignature(ctx, aftermarketDeviceAddr, m, aftermarketDeviceSig); err != nil {
+		return signatureError(err, ErrInvalidAdSignature)
+	}
+	return nil
+}
+
+// VerifyPairAftermarketDeviceSign checks the signatures passed to
+// PairAftermarketDeviceSign. aftermarketDeviceAddr is the address registered
+// for the aftermarket device node and vehicleOwner the owner of the vehicle node.
+func (v *Verifier) VerifyPairAftermarketDeviceSign(ctx context.Context, aftermarketDeviceNode *big.Int, vehicleNode *big.Int, aftermarketDeviceAddr common.Address, vehicleOwner common.Address, aftermarketDeviceSig []byte, vehicleOwnerSig []byte) error {
+	m := &PairAftermarketDeviceSign{AftermarketDeviceNode: aftermarketDeviceNode, VehicleNode: vehicleNode}
+	if err := v.VerifySignature(ctx, aftermarketDeviceAddr, m, aftermarketDeviceSig); err != nil {
+		return signatureError(err, ErrInvalidAdSignature)
+	}
+	if err := v.VerifySignature(ctx, vehicleOwner, m, vehicleOwnerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// VerifyUnpairAftermarketDeviceSign checks the signature passed to
+// UnpairAftermarketDeviceSign, which may come from either owner.
+func (v *Verifier) VerifyUnpairAftermarketDeviceSign(ctx context.Context, aftermarketDeviceNode *big.Int, vehicleNode *big.Int, aftermarketDeviceOwner common.Address, vehicleOwner common.Address, signature []byte) error {
+	// The registry checks both signatures before comparing, so either owner
+	// being zero reverts even if the other signed.
+	if aftermarketDeviceOwner == (common.Address{}) || vehicleOwner == (common.Address{}) {
+		return ErrZeroSignatory
+	}
+	m := &UnPairAftermarketDeviceSign{AftermarketDeviceNode: aftermarketDeviceNode, VehicleNode: vehicleNode}
+	err := v.VerifySignature(ctx, aftermarketDeviceOwner, m, signature)
+	if err == nil {
+		return nil
+	}
+	if err = v.VerifySignature(ctx, vehicleOwner, m, signature); err == nil {
+		return nil
+	}
+	return signatureError(err, ErrInvalidSigner)
+}
+
+// VerifyMintSyntheticDeviceSign checks the signatures in the input passed to
+// MintSyntheticDeviceSign. vehicleOwner is the owner of data.VehicleNode.
+func (v *Verifier) VerifyMintSyntheticDeviceSign(ctx context.Context, data contracts.MintSyntheticDeviceInput, vehicleOwner common.Address) error {
+	m := &MintSyntheticDeviceSign{ConnectionId: data.ConnectionId, VehicleNode: data.VehicleNode}
+	if err := v.VerifySignature(ctx, data.SyntheticDeviceAddr, m, data.SyntheticDeviceSig); err != nil {
+		return signatureError(err, ErrInvalidSdSignature)
+	}
+	if err := v.VerifySignature(ctx, vehicleOwner, m, data.VehicleOwnerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// VerifyBurnSyntheticDeviceSign checks the owner signature passed to
+// BurnSyntheticDeviceSign.
+func (v *Verifier) VerifyBurnSyntheticDeviceSign(ctx context.Context, vehicleNode *big.Int, syntheticDeviceNode *big.Int, owner common.Address, ownerSig []byte) error {
+	m := &BurnSyntheticDeviceSign{VehicleNode: vehicleNode, SyntheticDeviceNode: syntheticDeviceNode}
+	if err := v.VerifySignature(ctx, owner, m, ownerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// VerifyMintVehicleAndSdInput checks the signatures in the input passed to
+// MintVehicleAndSdSign0.
+func (v *Verifier) VerifyMintVehicleAndSdInput(ctx context.Context, data contracts.MintVehicleAndSdInput) error {
+	owner := NewMintVehicleSign(data.ManufacturerNode, data.Owner, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// VerifyMintVehicleAndSdInputWithSnId checks the signatures in the input passed
+// to MintVehicleAndSdSign.
+func (v *Verifier) VerifyMintVehicleAndSdInputWithSnId(ctx context.Context, data contracts.MintVehicleAndSdInputWithSnId) error {
+	owner := NewMintVehicleSign(data.ManufacturerNode, data.Owner, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// VerifyMintVehicleAndSdWithDdInput checks the signatures in the input passed
+// to MintVehicleAndSdWithDeviceDefinitionSign0 and
+// MintVehicleAndSdWithDeviceDefinitionSignAndSacd.
+func (v *Verifier) VerifyMintVehicleAndSdWithDdInput(ctx context.Context, data contracts.MintVehicleAndSdWithDdInput) error {
+	owner := NewMintVehicleWithDeviceDefinitionSign(data.ManufacturerNode, data.Owner, data.DeviceDefinitionId, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// VerifyMintVehicleAndSdWithDdInputWithSnId checks the signatures in the input
+// passed to MintVehicleAndSdWithDeviceDefinitionSign and
+// MintVehicleAndSdWithDeviceDefinitionSignAndSacd0.
+func (v *Verifier) VerifyMintVehicleAndSdWithDdInputWithSnId(ctx context.Context, data contracts.MintVehicleAndSdWithDdInputWithSnId) error {
+	owner := NewMintVehicleWithDeviceDefinitionSign(data.ManufacturerNode, data.Owner, data.DeviceDefinitionId, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// VerifyMintVehicleAndSdWithDdInputBatch checks the signatures of one element
+// of the input passed to MintVehicleAndSdWithDeviceDefinitionSignBatch0.
+func (v *Verifier) VerifyMintVehicleAndSdWithDdInputBatch(ctx context.Context, data contracts.MintVehicleAndSdWithDdInputBatch) error {
+	owner := NewMintVehicleWithDeviceDefinitionSign(data.ManufacturerNode, data.Owner, data.DeviceDefinitionId, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// VerifyMintVehicleAndSdWithDdInputWithSnIdBatch checks the signatures of one
+// element of the input passed to MintVehicleAndSdWithDeviceDefinitionSignBatch.
+func (v *Verifier) VerifyMintVehicleAndSdWithDdInputWithSnIdBatch(ctx context.Context, data contracts.MintVehicleAndSdWithDdInputWithSnIdBatch) error {
+	owner := NewMintVehicleWithDeviceDefinitionSign(data.ManufacturerNode, data.Owner, data.DeviceDefinitionId, data.AttrInfoPairsVehicle)
+	return v.verifyMintVehicleAndSd(ctx, data.ConnectionId, data.SyntheticDeviceAddr, data.SyntheticDeviceSig, data.Owner, owner, data.VehicleOwnerSig)
+}
+
+// verifyMintVehicleAndSd checks the synthetic device signature before the
+// owner signature, in the order MultipleMinter reverts.
+func (v *Verifier) verifyMintVehicleAndSd(ctx context.Context, connectionId *big.Int, sdAddr common.Address, sdSig []byte, owner common.Address, ownerMsg Message, ownerSig []byte) error {
+	if err := v.VerifySignature(ctx, sdAddr, &MintVehicleAndSdSign{ConnectionId: connectionId}, sdSig); err != nil {
+		return signatureError(err, ErrInvalidSdSignature)
+	}
+	if err := v.VerifySignature(ctx, owner, ownerMsg, ownerSig); err != nil {
+		return signatureError(err, ErrInvalidOwnerSignature)
+	}
+	return nil
+}
+
+// signatureError replaces a failed signature check with the operation error,
+// leaving backend errors untouched. ErrZeroSignatory is kept as well, since the
+// registry reverts with "ECDSA: zero signatory address" rather than the
+// operation error.
+func signatureError(err error, opErr error) error {
+	switch {
+	case errors.Is(err, ErrInvalidSignature),
+		errors.Is(err, ErrInvalidSignatureLength),
+		errors.Is(err, ErrInvalidSignatureS),
+		errors.Is(err, ErrSignerMismatch):
+		return opErr
+	}
+	return err
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
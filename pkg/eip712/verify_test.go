@@ -0,0 +1,191 @@
+package eip712
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newKey(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, m Message) []byte {
+	t.Helper()
+	sig, err := Sign(key, testDomain, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// highS returns the malleable twin of sig: s' = n - s with the recovery id
+// flipped, which recovers the same address but ECDSA.tryRecover rejects.
+func highS(sig []byte) []byte {
+	out := append([]byte(nil), sig...)
+	s := new(big.Int).SetBytes(sig[32:64])
+	s.Sub(crypto.S256().Params().N, s)
+	copy(out[32:64], common.LeftPadBytes(s.Bytes(), 32))
+	out[crypto.RecoveryIDOffset] ^= 1
+	return out
+}
+
+func TestRecover(t *testing.T) {
+	key, addr := newKey(t)
+	m := &BurnVehicleSign{VehicleNode: big.NewInt(1)}
+	sig := sign(t, key, m)
+
+	badV := append([]byte(nil), sig...)
+	badV[crypto.RecoveryIDOffset] = 1
+
+	tests := []struct {
+		name string
+		sig  []byte
+		want error
+	}{
+		{"valid", sig, nil},
+		{"short", sig[:64], ErrInvalidSignatureLength},
+		{"high s", highS(sig), ErrInvalidSignatureS},
+		{"v not 27 or 28", badV, ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Recover(testDomain, m, tt.sig)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Recover() error = %v, want %v", err, tt.want)
+			}
+			if err == nil && got != addr {
+				t.Errorf("Recover() = %s, want %s", got, addr)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	ctx := context.Background()
+	key, addr := newKey(t)
+	_, other := newKey(t)
+	m := &BurnVehicleSign{VehicleNode: big.NewInt(1)}
+	sig := sign(t, key, m)
+	v := NewVerifier(testDomain, nil)
+
+	if err := v.VerifySignature(ctx, addr, m, sig); err != nil {
+		t.Errorf("signatory: %v", err)
+	}
+	if err := v.VerifySignature(ctx, other, m, sig); !errors.Is(err, ErrSignerMismatch) {
+		t.Errorf("other signatory: %v, want %v", err, ErrSignerMismatch)
+	}
+	if err := v.VerifySignature(ctx, addr, &BurnVehicleSign{VehicleNode: big.NewInt(2)}, sig); !errors.Is(err, ErrSignerMismatch) {
+		t.Errorf("other message: %v, want %v", err, ErrSignerMismatch)
+	}
+	if err := v.VerifySignature(ctx, common.Address{}, m, sig); !errors.Is(err, ErrZeroSignatory) {
+		t.Errorf("zero signatory: %v, want %v", err, ErrZeroSignatory)
+	}
+}
+
+func TestVerifyPairAftermarketDeviceSign(t *testing.T) {
+	ctx := context.Background()
+	adKey, adAddr := newKey(t)
+	ownerKey, owner := newKey(t)
+	m := &PairAftermarketDeviceSign{AftermarketDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(42)}
+	adSig, ownerSig := sign(t, adKey, m), sign(t, ownerKey, m)
+	v := NewVerifier(testDomain, nil)
+
+	tests := []struct {
+		name            string
+		adAddr, owner   common.Address
+		adSig, ownerSig []byte
+		want            error
+	}{
+		{"valid", adAddr, owner, adSig, ownerSig, nil},
+		{"swapped", adAddr, owner, ownerSig, adSig, ErrInvalidAdSignature},
+		{"bad owner signature", adAddr, owner, adSig, adSig, ErrInvalidOwnerSignature},
+		{"malformed owner signature", adAddr, owner, adSig, ownerSig[:10], ErrInvalidOwnerSignature},
+		// The registry reverts with "ECDSA: zero signatory address" instead of
+		// the operation error.
+		{"zero owner", adAddr, common.Address{}, adSig, ownerSig, ErrZeroSignatory},
+		{"zero device", common.Address{}, owner, adSig, ownerSig, ErrZeroSignatory},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.VerifyPairAftermarketDeviceSign(ctx, m.AftermarketDeviceNode, m.VehicleNode, tt.adAddr, tt.owner, tt.adSig, tt.ownerSig)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyUnpairAftermarketDeviceSign(t *testing.T) {
+	ctx := context.Background()
+	adOwnerKey, adOwner := newKey(t)
+	vehicleOwnerKey, vehicleOwner := newKey(t)
+	strangerKey, _ := newKey(t)
+	m := &UnPairAftermarketDeviceSign{AftermarketDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(42)}
+	v := NewVerifier(testDomain, nil)
+
+	tests := []struct {
+		name                  string
+		adOwner, vehicleOwner common.Address
+		sig                   []byte
+		want                  error
+	}{
+		{"device owner", adOwner, vehicleOwner, sign(t, adOwnerKey, m), nil},
+		{"vehicle owner", adOwner, vehicleOwner, sign(t, vehicleOwnerKey, m), nil},
+		{"stranger", adOwner, vehicleOwner, sign(t, strangerKey, m), ErrInvalidSigner},
+		{"zero vehicle owner", adOwner, common.Address{}, sign(t, adOwnerKey, m), ErrZeroSignatory},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.VerifyUnpairAftermarketDeviceSign(ctx, m.AftermarketDeviceNode, m.VehicleNode, tt.adOwner, tt.vehicleOwner, tt.sig)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// erc1271Caller answers isValidSignature for one contract signatory.
+type erc1271Caller struct {
+	signatory common.Address
+	valid     bool
+}
+
+func (c *erc1271Caller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (c *erc1271Caller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *msg.To != c.signatory || !c.valid {
+		return make([]byte, 32), nil
+	}
+	return common.RightPadBytes(erc1271MagicValue[:], 32), nil
+}
+
+func TestVerifySignatureERC1271(t *testing.T) {
+	ctx := context.Background()
+	signatory := common.HexToAddress("0xc0ffee")
+	m := &BurnVehicleSign{VehicleNode: big.NewInt(1)}
+	sig := []byte("contract signature")
+
+	v := NewVerifier(testDomain, &erc1271Caller{signatory: signatory, valid: true})
+	if err := v.VerifySignature(ctx, signatory, m, sig); err != nil {
+		t.Errorf("valid contract signature: %v", err)
+	}
+
+	v = NewVerifier(testDomain, &erc1271Caller{signatory: signatory})
+	if err := v.VerifySignature(ctx, signatory, m, sig); !errors.Is(err, ErrInvalidSignatureLength) {
+		t.Errorf("rejected contract signature: %v, want %v", err, ErrInvalidSignatureLength)
+	}
+}
@@ -0,0 +1,352 @@
+// Package eventstream delivers DIMORegistry logs with explicit notifications
+// for chain reorganizations and for logs that reached a confirmation depth.
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultChunkSize    = 2000
+)
+
+// ErrReorgTooDeep is returned when a block that was already reported as final
+// is no longer part of the canonical chain.
+var ErrReorgTooDeep = errors.New("eventstream: reorg deeper than the confirmation depth")
+
+// Kind is the kind of an Event.
+type Kind int
+
+const (
+	// Pending is a log in a block that has not reached the confirmation
+	// depth yet. It may later be rolled back.
+	Pending Kind = iota
+	// Final is a log whose block reached the confirmation depth. It is
+	// never rolled back.
+	Final
+	// Rollback undoes a Pending log whose block left the canonical chain.
+	// Rollbacks are delivered newest first.
+	Rollback
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Pending:
+		return "pending"
+	case Final:
+		return "final"
+	case Rollback:
+		return "rollback"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Event is a notification about a registry log. Name is the registry event
+// name of the log, empty for unknown topics; the log can be decoded with the
+// matching RegistryFilterer.Parse method.
+type Event struct {
+	Kind Kind
+	Name string
+	Log  types.Log
+}
+
+// Backend is the chain access needed by a Stream.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// Config configures a Stream.
+type Config struct {
+	// Registry is the DIMORegistry address.
+	Registry common.Address
+	// FromBlock is the first block to read logs from.
+	FromBlock uint64
+	// Confirmations is the number of blocks on top of a log's block before
+	// the log is final. With zero, every log is final as soon as it is seen.
+	Confirmations uint64
+	// ChunkSize is the maximum number of blocks requested per eth_getLogs
+	// call. Defaults to 2000.
+	ChunkSize uint64
+	// PollInterval is how often Run polls the head. Defaults to 5s.
+	PollInterval time.Duration
+}
+
+// trackedBlock is a block inside the confirmation window.
+type trackedBlock struct {
+	number uint64
+	hash   common.Hash
+	logs   []types.Log
+}
+
+// Stream polls the registry logs and tracks the hashes of the blocks inside
+// the confirmation window to detect reorganizations. It is not safe for
+// concurrent use.
+type Stream struct {
+	backend Backend
+	cfg     Config
+	events  map[common.Hash]string
+
+	next      uint64
+	window    []trackedBlock
+	lastFinal *trackedBlock
+}
+
+// New returns a Stream starting at cfg.FromBlock.
+func New(backend Backend, cfg Config) (*Stream, error) {
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	if cfg.ChunkSize == 0 {
+		cfg.ChunkSize = defaultChunkSize
+	}
+
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	events := make(map[common.Hash]string, len(parsed.Events))
+	for name, ev := range parsed.Events {
+		events[ev.ID] = name
+	}
+	return &Stream{backend: backend, cfg: cfg, events: events, next: cfg.FromBlock}, nil
+}
+
+// Run polls the chain and sends events to sink until ctx is done or an error
+// occurs.
+func (s *Stream) Run(ctx context.Context, sink chan<- Event) error {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			select {
+			case sink <- ev:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll performs a single step: it rolls back blocks that left the canonical
+// chain, reads the logs of new blocks and finalizes blocks that reached the
+// confirmation depth. The returned events must be applied in order.
+func (s *Stream) Poll(ctx context.Context) ([]Event, error) {
+	events, err := s.rollback(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	headNumber := head.Number.Uint64()
+
+	for s.next <= headNumber {
+		to := s.next + s.cfg.ChunkSize - 1
+		if to > headNumber {
+			to = headNumber
+		}
+		scanned, ok, err := s.scan(ctx, s.next, to, headNumber)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, scanned...)
+		if !ok {
+			// The chain changed while scanning, the next poll rolls back
+			// and retries.
+			break
+		}
+		s.next = to + 1
+	}
+
+	return append(events, s.finalize(headNumber)...), nil
+}
+
+// rollback drops the tracked blocks that are no longer canonical and returns
+// Rollback events for their logs.
+func (s *Stream) rollback(ctx context.Context) ([]Event, error) {
+	fork := 0
+	for i := len(s.window) - 1; i >= 0; i-- {
+		canonical, err := s.isCanonical(ctx, s.window[i])
+		if err != nil {
+			return nil, err
+		}
+		if canonical {
+			fork = i + 1
+			break
+		}
+	}
+	if fork == 0 && s.lastFinal != nil {
+		canonical, err := s.isCanonical(ctx, *s.lastFinal)
+		if err != nil {
+			return nil, err
+		}
+		if !canonical {
+			return nil, ErrReorgTooDeep
+		}
+	}
+	if fork == len(s.window) {
+		return nil, nil
+	}
+
+	var events []Event
+	for i := len(s.window) - 1; i >= fork; i-- {
+		logs := s.window[i].logs
+		for j := len(logs) - 1; j >= 0; j-- {
+			removed := logs[j]
+			removed.Removed = true
+			events = append(events, s.event(Rollback, removed))
+		}
+	}
+	s.next = s.window[fork].number
+	s.window = s.window[:fork]
+	return events, nil
+}
+
+func (s *Stream) event(kind Kind, log types.Log) Event {
+	ev := Event{Kind: kind, Log: log}
+	if len(log.Topics) > 0 {
+		ev.Name = s.events[log.Topics[0]]
+	}
+	return ev
+}
+
+func (s *Stream) isCanonical(ctx context.Context, b trackedBlock) (bool, error) {
+	header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(b.number))
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return header.Hash() == b.hash, nil
+}
+
+// scan reads the logs of blocks from to to. Blocks already past the
+// confirmation depth are final right away, the others are tracked. ok is
+// false if a log does not belong to the block fetched for its height.
+func (s *Stream) scan(ctx context.Context, from, to, head uint64) (events []Event, ok bool, err error) {
+	logs, err := s.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{s.cfg.Registry},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	byBlock := make(map[uint64][]types.Log)
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		byBlock[log.BlockNumber] = append(byBlock[log.BlockNumber], log)
+	}
+
+	var (
+		tracked   []trackedBlock
+		lastFinal *trackedBlock
+	)
+	for n := from; n <= to; n++ {
+		if n+s.cfg.Confirmations <= head {
+			for _, log := range byBlock[n] {
+				events = append(events, s.event(Final, log))
+			}
+			if n == to || n+1+s.cfg.Confirmations > head {
+				// Remember the newest final block so that a reorg below the
+				// window is detected.
+				header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+				if err != nil {
+					return nil, false, err
+				}
+				lastFinal = &trackedBlock{number: n, hash: header.Hash()}
+			}
+			continue
+		}
+
+		header, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, false, err
+		}
+		hash := header.Hash()
+		if parent := s.parent(tracked, lastFinal); parent != nil && parent.number+1 == n && header.ParentHash != parent.hash {
+			return nil, false, nil
+		}
+		for _, log := range byBlock[n] {
+			if log.BlockHash != hash {
+				return nil, false, nil
+			}
+		}
+		tracked = append(tracked, trackedBlock{number: n, hash: hash, logs: byBlock[n]})
+	}
+
+	for _, b := range tracked {
+		for _, log := range b.logs {
+			events = append(events, s.event(Pending, log))
+		}
+	}
+	if lastFinal != nil {
+		s.lastFinal = lastFinal
+	}
+	s.window = append(s.window, tracked...)
+	return events, true, nil
+}
+
+// parent returns the newest block known before the next block of a scan:
+// the last block tracked or finalized by the scan, else the end of the
+// window, else the last final block of a previous poll, e.g. when a rollback
+// emptied the window.
+func (s *Stream) parent(tracked []trackedBlock, lastFinal *trackedBlock) *trackedBlock {
+	switch {
+	case len(tracked) > 0:
+		return &tracked[len(tracked)-1]
+	case lastFinal != nil:
+		return lastFinal
+	case len(s.window) > 0:
+		return &s.window[len(s.window)-1]
+	}
+	return s.lastFinal
+}
+
+// finalize reports the logs of tracked blocks that reached the confirmation
+// depth as Final and stops tracking those blocks.
+func (s *Stream) finalize(head uint64) []Event {
+	var events []Event
+	i := 0
+	for ; i < len(s.window) && s.window[i].number+s.cfg.Confirmations <= head; i++ {
+		for _, log := range s.window[i].logs {
+			events = append(events, s.event(Final, log))
+		}
+		final := s.window[i]
+		s.lastFinal = &final
+	}
+	s.window = s.window[i:]
+	return events
+}
@@ -0,0 +1,209 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ Backend = simulated.Client(nil)
+
+func newStream(t *testing.T, c *simtest.Chain, registry common.Address, confirmations uint64) *Stream {
+	t.Helper()
+	s, err := New(c.Client, Config{Registry: registry, FromBlock: c.Head(t) + 1, Confirmations: confirmations})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func poll(t *testing.T, s *Stream) []Event {
+	t.Helper()
+	events, err := s.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func blockHash(t *testing.T, c *simtest.Chain, n uint64) common.Hash {
+	t.Helper()
+	header, err := c.Client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return header.Hash()
+}
+
+// checkEvents compares the kind, name and transaction of events.
+func checkEvents(t *testing.T, got []Event, want ...Event) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d events %v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Kind != w.Kind || g.Name != w.Name || g.Log.TxHash != w.Log.TxHash || g.Log.Removed != (w.Kind == Rollback) {
+			t.Errorf("event %d = %s %s of %s (removed %t), want %s %s of %s",
+				i, g.Kind, g.Name, g.Log.TxHash, g.Log.Removed, w.Kind, w.Name, w.Log.TxHash)
+		}
+	}
+}
+
+func emitAttribute(t *testing.T, c *simtest.Chain, registry common.Address, id int64) *types.Transaction {
+	t.Helper()
+	return c.EmitEvent(t, registry, "VehicleAttributeSet", big.NewInt(id), "Make", "Ford")
+}
+
+func TestStreamFinalization(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	s := newStream(t, c, registry, 3)
+
+	tx := emitAttribute(t, c, registry, 1)
+	c.Commit()
+	pending := Event{Kind: Pending, Name: "VehicleAttributeSet", Log: types.Log{TxHash: tx.Hash()}}
+	checkEvents(t, poll(t, s), pending)
+
+	for i := 0; i < 2; i++ {
+		c.Commit()
+		checkEvents(t, poll(t, s))
+	}
+	c.Commit()
+	checkEvents(t, poll(t, s), Event{Kind: Final, Name: "VehicleAttributeSet", Log: types.Log{TxHash: tx.Hash()}})
+
+	// Logs already past the confirmation depth when first seen are final
+	// right away.
+	tx = emitAttribute(t, c, registry, 2)
+	c.Commit()
+	c.Mine(t, 3)
+	checkEvents(t, poll(t, s), Event{Kind: Final, Name: "VehicleAttributeSet", Log: types.Log{TxHash: tx.Hash()}})
+}
+
+func TestStreamRollback(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	s := newStream(t, c, registry, 3)
+
+	fork := c.Mine(t, 1)
+	first := emitAttribute(t, c, registry, 1)
+	second := emitAttribute(t, c, registry, 2)
+	c.Commit()
+	third := emitAttribute(t, c, registry, 3)
+	c.Commit()
+	checkEvents(t, poll(t, s),
+		Event{Kind: Pending, Name: "VehicleAttributeSet", Log: types.Log{TxHash: first.Hash()}},
+		Event{Kind: Pending, Name: "VehicleAttributeSet", Log: types.Log{TxHash: second.Hash()}},
+		Event{Kind: Pending, Name: "VehicleAttributeSet", Log: types.Log{TxHash: third.Hash()}},
+	)
+
+	// Replace both blocks with empty ones. The dropped transactions go back
+	// to the pool and are mined again by the next commit.
+	if err := c.Fork(blockHash(t, c, fork)); err != nil {
+		t.Fatal(err)
+	}
+	c.Mine(t, 1)
+	events := poll(t, s)
+	checkEvents(t, events[:3],
+		Event{Kind: Rollback, Name: "VehicleAttributeSet", Log: types.Log{TxHash: third.Hash()}},
+		Event{Kind: Rollback, Name: "VehicleAttributeSet", Log: types.Log{TxHash: second.Hash()}},
+		Event{Kind: Rollback, Name: "VehicleAttributeSet", Log: types.Log{TxHash: first.Hash()}},
+	)
+	for _, ev := range events[3:] {
+		if ev.Kind != Pending {
+			t.Errorf("%s event after the rollback, want pending", ev.Kind)
+		}
+		if want := blockHash(t, c, ev.Log.BlockNumber); ev.Log.BlockHash != want {
+			t.Errorf("pending log of block %s, want canonical %s", ev.Log.BlockHash, want)
+		}
+	}
+}
+
+func TestStreamReorgTooDeep(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	s := newStream(t, c, registry, 2)
+
+	emitAttribute(t, c, registry, 1)
+	c.Commit()
+	head := c.Mine(t, 2)
+	events := poll(t, s)
+	if len(events) != 1 || events[0].Kind != Final {
+		t.Fatalf("events = %v, want one final", events)
+	}
+
+	// Replace the final block.
+	if err := c.Fork(blockHash(t, c, head-3)); err != nil {
+		t.Fatal(err)
+	}
+	c.Mine(t, 4)
+	if _, err := s.Poll(context.Background()); !errors.Is(err, ErrReorgTooDeep) {
+		t.Fatalf("Poll() = %v, want %v", err, ErrReorgTooDeep)
+	}
+}
+
+// hookBackend calls hook once, before the next FilterLogs.
+type hookBackend struct {
+	Backend
+
+	mu   sync.Mutex
+	hook func()
+}
+
+func (b *hookBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	hook := b.hook
+	b.hook = nil
+	b.mu.Unlock()
+	if hook != nil {
+		hook()
+	}
+	return b.Backend.FilterLogs(ctx, q)
+}
+
+// TestStreamReorgAfterEmptyWindow replaces the last final block while the
+// stream scans the first block after a rollback emptied its window, which
+// must be checked against the last final block of the previous poll.
+func TestStreamReorgAfterEmptyWindow(t *testing.T) {
+	c := simtest.New(t)
+	backend := &hookBackend{Backend: c.Client}
+	s, err := New(backend, Config{FromBlock: c.Head(t) + 1, Confirmations: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head := c.Mine(t, 5)
+	poll(t, s)
+	if s.lastFinal == nil || s.lastFinal.number != head-2 || len(s.window) != 2 {
+		t.Fatalf("lastFinal = %v, window = %v, want block %d and 2 blocks", s.lastFinal, s.window, head-2)
+	}
+
+	// Replace the window only, then, while the stream scans the new blocks,
+	// the last final block too.
+	if err := c.Fork(blockHash(t, c, head-2)); err != nil {
+		t.Fatal(err)
+	}
+	c.Mine(t, 2)
+	backend.hook = func() {
+		if err := c.Fork(blockHash(t, c, head-3)); err != nil {
+			t.Error(err)
+		}
+		c.Mine(t, 3)
+	}
+	poll(t, s)
+	if len(s.window) != 0 {
+		t.Errorf("window = %v after a scan across a replaced final block, want empty", s.window)
+	}
+	if _, err := s.Poll(context.Background()); !errors.Is(err, ErrReorgTooDeep) {
+		t.Fatalf("Poll() = %v, want %v", err, ErrReorgTooDeep)
+	}
+}
@@ -0,0 +1,333 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/aftermarketDeviceId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/manufacturerId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/syntheticDeviceId"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/vehicleId"
+	"github.com/DIMO-Network/dimo-identity/pkg/receipts"
+)
+
+// transferParser decodes the ERC-721 Transfer event of one NFT proxy and
+// names the table holding its nodes.
+type transferParser struct {
+	table string
+	parse func(types.Log) (to common.Address, tokenId *big.Int, err error)
+}
+
+func newTransferParsers(cfg Config) (map[common.Address]transferParser, error) {
+	parsers := make(map[common.Address]transferParser)
+
+	if cfg.ManufacturerId != (common.Address{}) {
+		f, err := manufacturerId.NewManufacturerIdFilterer(cfg.ManufacturerId, nil)
+		if err != nil {
+			return nil, err
+		}
+		parsers[cfg.ManufacturerId] = transferParser{table: "manufacturers", parse: func(log types.Log) (common.Address, *big.Int, error) {
+			ev, err := f.ParseTransfer(log)
+			if err != nil {
+				return common.Address{}, nil, err
+			}
+			return ev.To, ev.TokenId, nil
+		}}
+	}
+	if cfg.VehicleId != (common.Address{}) {
+		f, err := vehicleId.NewVehicleIdFilterer(cfg.VehicleId, nil)
+		if err != nil {
+			return nil, err
+		}
+		parsers[cfg.VehicleId] = transferParser{table: "vehicles", parse: func(log types.Log) (common.Address, *big.Int, error) {
+			ev, err := f.ParseTransfer(log)
+			if err != nil {
+				return common.Address{}, nil, err
+			}
+			return ev.To, ev.TokenId, nil
+		}}
+	}
+	if cfg.AftermarketDeviceId != (common.Address{}) {
+		f, err := aftermarketDeviceId.NewAftermarketDeviceIdFilterer(cfg.AftermarketDeviceId, nil)
+		if err != nil {
+			return nil, err
+		}
+		parsers[cfg.AftermarketDeviceId] = transferParser{table: "aftermarket_devices", parse: func(log types.Log) (common.Address, *big.Int, error) {
+			ev, err := f.ParseTransfer(log)
+			if err != nil {
+				return common.Address{}, nil, err
+			}
+			return ev.To, ev.TokenId, nil
+		}}
+	}
+	if cfg.SyntheticDeviceId != (common.Address{}) {
+		f, err := syntheticDeviceId.NewSyntheticDeviceIdFilterer(cfg.SyntheticDeviceId, nil)
+		if err != nil {
+			return nil, err
+		}
+		parsers[cfg.SyntheticDeviceId] = transferParser{table: "synthetic_devices", parse: func(log types.Log) (common.Address, *big.Int, error) {
+			ev, err := f.ParseTransfer(log)
+			if err != nil {
+				return common.Address{}, nil, err
+			}
+			return ev.To, ev.TokenId, nil
+		}}
+	}
+	return parsers, nil
+}
+
+// applyTransfer updates the owner of an existing node. Transfers to the zero
+// address are burns, which the registry reports with its own events.
+func (ix *Indexer) applyTransfer(ctx context.Context, tx *sql.Tx, p transferParser, log types.Log) error {
+	to, tokenId, err := p.parse(log)
+	if err != nil {
+		// Other events of the proxy, such as approvals, are not indexed.
+		return nil
+	}
+	if to == (common.Address{}) {
+		return nil
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE `+p.table+` SET owner = ? WHERE id = ?`, to.Hex(), tokenId.String())
+	return err
+}
+
+func (ix *Indexer) applyRegistryLog(ctx context.Context, tx *sql.Tx, log types.Log) error {
+	f := ix.filterer
+	exec := func(query string, args ...interface{}) error {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	}
+
+	switch ix.events[log.Topics[0]] {
+	case "ManufacturerNodeMinted":
+		ev, err := f.ParseManufacturerNodeMinted(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO manufacturers (id, name, owner) VALUES (?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET name = excluded.name, owner = excluded.owner`,
+			ev.TokenId.String(), ev.Name, ev.Owner.Hex())
+
+	case "ManufacturerAttributeSet":
+		ev, err := f.ParseManufacturerAttributeSet(log)
+		if err != nil {
+			return err
+		}
+		return setAttribute(exec, receipts.Manufacturer, ev.TokenId, ev.Attribute, ev.Info)
+
+	case "ManufacturerTableSet":
+		ev, err := f.ParseManufacturerTableSet(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE manufacturers SET table_id = ? WHERE id = ?`, ev.TableId.String(), ev.ManufacturerId.String())
+
+	case "DeviceDefinitionTableCreated":
+		ev, err := f.ParseDeviceDefinitionTableCreated(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE manufacturers SET table_id = ? WHERE id = ?`, ev.TableId.String(), ev.ManufacturerId.String())
+
+	case "VehicleNodeMinted":
+		ev, err := f.ParseVehicleNodeMinted(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO vehicles (id, manufacturer_id, owner) VALUES (?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET manufacturer_id = excluded.manufacturer_id, owner = excluded.owner, burned = 0`,
+			ev.TokenId.String(), ev.ManufacturerNode.String(), ev.Owner.Hex())
+
+	case "VehicleNodeMintedWithDeviceDefinition":
+		ev, err := f.ParseVehicleNodeMintedWithDeviceDefinition(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO vehicles (id, manufacturer_id, owner, device_definition_id) VALUES (?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET manufacturer_id = excluded.manufacturer_id, owner = excluded.owner,
+				device_definition_id = excluded.device_definition_id, burned = 0`,
+			ev.VehicleId.String(), ev.ManufacturerId.String(), ev.Owner.Hex(), ev.DeviceDefinitionId)
+
+	case "VehicleAttributeSet":
+		ev, err := f.ParseVehicleAttributeSet(log)
+		if err != nil {
+			return err
+		}
+		return setAttribute(exec, receipts.Vehicle, ev.TokenId, ev.Attribute, ev.Info)
+
+	case "DeviceDefinitionIdSet":
+		ev, err := f.ParseDeviceDefinitionIdSet(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE vehicles SET device_definition_id = ? WHERE id = ?`, ev.DdId, ev.VehicleId.String())
+
+	case "VehicleStorageNodeIdSet":
+		ev, err := f.ParseVehicleStorageNodeIdSet(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE vehicles SET storage_node_id = ? WHERE id = ?`, ev.StorageNodeId.String(), ev.VehicleId.String())
+
+	case "VehicleStreamSet":
+		ev, err := f.ParseVehicleStreamSet(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO vehicle_streams (vehicle_id, stream_id) VALUES (?, ?)
+			ON CONFLICT (vehicle_id) DO UPDATE SET stream_id = excluded.stream_id`,
+			ev.VehicleId.String(), ev.StreamId)
+
+	case "VehicleStreamUnset":
+		ev, err := f.ParseVehicleStreamUnset(log)
+		if err != nil {
+			return err
+		}
+		return exec(`DELETE FROM vehicle_streams WHERE vehicle_id = ?`, ev.VehicleId.String())
+
+	case "VehicleNodeBurned":
+		ev, err := f.ParseVehicleNodeBurned(log)
+		if err != nil {
+			return err
+		}
+		id := ev.VehicleNode.String()
+		if err := exec(`UPDATE vehicles SET burned = 1, device_definition_id = NULL WHERE id = ?`, id); err != nil {
+			return err
+		}
+		if err := exec(`DELETE FROM pairings WHERE vehicle_id = ?`, id); err != nil {
+			return err
+		}
+		if err := exec(`DELETE FROM vehicle_streams WHERE vehicle_id = ?`, id); err != nil {
+			return err
+		}
+		return resetAttributes(exec, receipts.Vehicle, ev.VehicleNode)
+
+	case "AftermarketDeviceNodeMinted":
+		ev, err := f.ParseAftermarketDeviceNodeMinted(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO aftermarket_devices (id, manufacturer_id, address, owner) VALUES (?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET manufacturer_id = excluded.manufacturer_id, address = excluded.address,
+				owner = excluded.owner, claimed = 0, burned = 0`,
+			ev.TokenId.String(), ev.ManufacturerId.String(), ev.AftermarketDeviceAddress.Hex(), ev.Owner.Hex())
+
+	case "AftermarketDeviceAttributeSet":
+		ev, err := f.ParseAftermarketDeviceAttributeSet(log)
+		if err != nil {
+			return err
+		}
+		return setAttribute(exec, receipts.AftermarketDevice, ev.TokenId, ev.Attribute, ev.Info)
+
+	case "AftermarketDeviceClaimed":
+		ev, err := f.ParseAftermarketDeviceClaimed(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE aftermarket_devices SET claimed = 1, owner = ? WHERE id = ?`, ev.Owner.Hex(), ev.AftermarketDeviceNode.String())
+
+	case "AftermarketDeviceUnclaimed":
+		ev, err := f.ParseAftermarketDeviceUnclaimed(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE aftermarket_devices SET claimed = 0 WHERE id = ?`, ev.AftermarketDeviceNode.String())
+
+	case "AftermarketDeviceUnclaimed0":
+		ev, err := f.ParseAftermarketDeviceUnclaimed0(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE aftermarket_devices SET claimed = 0 WHERE id = ?`, ev.AftermarketDeviceNode.String())
+
+	case "AftermarketDeviceTransferred":
+		ev, err := f.ParseAftermarketDeviceTransferred(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE aftermarket_devices SET owner = ? WHERE id = ?`, ev.NewOwner.Hex(), ev.AftermarketDeviceNode.String())
+
+	case "AftermarketDeviceAddressReset":
+		ev, err := f.ParseAftermarketDeviceAddressReset(log)
+		if err != nil {
+			return err
+		}
+		return exec(`UPDATE aftermarket_devices SET address = ? WHERE id = ?`, ev.AftermarketDeviceAddress.Hex(), ev.TokenId.String())
+
+	case "AftermarketDevicePaired":
+		ev, err := f.ParseAftermarketDevicePaired(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO pairings (aftermarket_device_id, vehicle_id) VALUES (?, ?)
+			ON CONFLICT (aftermarket_device_id) DO UPDATE SET vehicle_id = excluded.vehicle_id`,
+			ev.AftermarketDeviceNode.String(), ev.VehicleNode.String())
+
+	case "AftermarketDeviceUnpaired":
+		ev, err := f.ParseAftermarketDeviceUnpaired(log)
+		if err != nil {
+			return err
+		}
+		return exec(`DELETE FROM pairings WHERE aftermarket_device_id = ?`, ev.AftermarketDeviceNode.String())
+
+	case "AftermarketDeviceNodeBurned":
+		ev, err := f.ParseAftermarketDeviceNodeBurned(log)
+		if err != nil {
+			return err
+		}
+		id := ev.TokenId.String()
+		if err := exec(`UPDATE aftermarket_devices SET burned = 1, claimed = 0 WHERE id = ?`, id); err != nil {
+			return err
+		}
+		if err := exec(`DELETE FROM pairings WHERE aftermarket_device_id = ?`, id); err != nil {
+			return err
+		}
+		return resetAttributes(exec, receipts.AftermarketDevice, ev.TokenId)
+
+	case "SyntheticDeviceNodeMinted":
+		ev, err := f.ParseSyntheticDeviceNodeMinted(log)
+		if err != nil {
+			return err
+		}
+		return exec(`INSERT INTO synthetic_devices (id, connection_id, vehicle_id, address, owner) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (id) DO UPDATE SET connection_id = excluded.connection_id, vehicle_id = excluded.vehicle_id,
+				address = excluded.address, owner = excluded.owner, burned = 0`,
+			ev.SyntheticDeviceNode.String(), ev.ConnectionId.String(), ev.VehicleNode.String(),
+			ev.SyntheticDeviceAddress.Hex(), ev.Owner.Hex())
+
+	case "SyntheticDeviceAttributeSet":
+		ev, err := f.ParseSyntheticDeviceAttributeSet(log)
+		if err != nil {
+			return err
+		}
+		return setAttribute(exec, receipts.SyntheticDevice, ev.TokenId, ev.Attribute, ev.Info)
+
+	case "SyntheticDeviceNodeBurned":
+		ev, err := f.ParseSyntheticDeviceNodeBurned(log)
+		if err != nil {
+			return err
+		}
+		if err := exec(`UPDATE synthetic_devices SET burned = 1 WHERE id = ?`, ev.SyntheticDeviceNode.String()); err != nil {
+			return err
+		}
+		return resetAttributes(exec, receipts.SyntheticDevice, ev.SyntheticDeviceNode)
+	}
+
+	// Configuration events (proxies, roles, modules, ...) do not change the
+	// identity graph.
+	return nil
+}
+
+func setAttribute(exec func(string, ...interface{}) error, nodeType receipts.NodeType, tokenId *big.Int, attribute, info string) error {
+	return exec(`INSERT INTO attributes (node_type, node_id, attribute, info) VALUES (?, ?, ?, ?)
+		ON CONFLICT (node_type, node_id, attribute) DO UPDATE SET info = excluded.info`,
+		string(nodeType), tokenId.String(), attribute, info)
+}
+
+func resetAttributes(exec func(string, ...interface{}) error, nodeType receipts.NodeType, tokenId *big.Int) error {
+	return exec(`DELETE FROM attributes WHERE node_type = ? AND node_id = ?`, string(nodeType), tokenId.String())
+}
@@ -0,0 +1,232 @@
+// Package indexer rebuilds the manufacturer → vehicle → aftermarket device /
+// synthetic device graph from DIMORegistry events into SQLite.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	_ "modernc.org/sqlite"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+//go:embed schema.sql
+var schema string
+
+const (
+	defaultChunkSize    = 2000
+	defaultPollInterval = 5 * time.Second
+)
+
+// Backend is the chain access needed by the Indexer.
+type Backend interface {
+	ethereum.BlockNumberReader
+	ethereum.LogFilterer
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// Config configures an Indexer.
+type Config struct {
+	// Registry is the DIMORegistry address.
+	Registry common.Address
+	// StartBlock is the first block to index when there is no checkpoint,
+	// usually the registry deployment block.
+	StartBlock uint64
+	// ChunkSize is the number of blocks requested per eth_getLogs call.
+	// Defaults to 2000.
+	ChunkSize uint64
+	// Confirmations is the number of blocks Run stays behind the head.
+	Confirmations uint64
+	// PollInterval is how often Run checks for new blocks. Defaults to 5s.
+	PollInterval time.Duration
+
+	// Optional NFT proxy addresses. When set, their Transfer events keep the
+	// owner columns up to date.
+	ManufacturerId      common.Address
+	VehicleId           common.Address
+	AftermarketDeviceId common.Address
+	SyntheticDeviceId   common.Address
+}
+
+// Indexer applies registry events to the SQLite schema in schema.sql.
+type Indexer struct {
+	db        *sql.DB
+	backend   Backend
+	cfg       Config
+	filterer  *contracts.RegistryFilterer
+	events    map[common.Hash]string
+	transfers map[common.Address]transferParser
+}
+
+// Open opens the SQLite database at dsn with the driver registered by this
+// package, e.g. "file:identity.db" or "file::memory:?cache=shared".
+func Open(dsn string) (*sql.DB, error) {
+	return sql.Open("sqlite", dsn)
+}
+
+// New creates the schema in db if needed and returns an Indexer.
+func New(ctx context.Context, db *sql.DB, backend Backend, cfg Config) (*Indexer, error) {
+	if cfg.ChunkSize == 0 {
+		cfg.ChunkSize = defaultChunkSize
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	filterer, err := contracts.NewRegistryFilterer(cfg.Registry, nil)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	events := make(map[common.Hash]string, len(parsed.Events))
+	for name, ev := range parsed.Events {
+		events[ev.ID] = name
+	}
+
+	transfers, err := newTransferParsers(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Indexer{
+		db:        db,
+		backend:   backend,
+		cfg:       cfg,
+		filterer:  filterer,
+		events:    events,
+		transfers: transfers,
+	}, nil
+}
+
+// Checkpoint returns the last indexed block. ok is false if nothing has been
+// indexed yet.
+func (ix *Indexer) Checkpoint(ctx context.Context) (block uint64, ok bool, err error) {
+	err = ix.db.QueryRowContext(ctx, `SELECT block_number FROM checkpoint WHERE id = 1`).Scan(&block)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return block, true, nil
+}
+
+// Backfill indexes every block from the checkpoint, or Config.StartBlock, up
+// to and including to. Each chunk is applied in a single database
+// transaction together with its checkpoint.
+func (ix *Indexer) Backfill(ctx context.Context, to uint64) error {
+	from := ix.cfg.StartBlock
+	last, ok, err := ix.Checkpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if ok {
+		from = last + 1
+	}
+
+	for from <= to {
+		end := from + ix.cfg.ChunkSize - 1
+		if end > to {
+			end = to
+		}
+		if err := ix.indexRange(ctx, from, end); err != nil {
+			return fmt.Errorf("failed to index blocks %d-%d: %w", from, end, err)
+		}
+		from = end + 1
+	}
+	return nil
+}
+
+// Run backfills up to the head minus Config.Confirmations and then follows
+// the chain until ctx is done.
+func (ix *Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(ix.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		head, err := ix.backend.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		if head >= ix.cfg.Confirmations {
+			if err := ix.Backfill(ctx, head-ix.cfg.Confirmations); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ix *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	addresses := []common.Address{ix.cfg.Registry}
+	for addr := range ix.transfers {
+		addresses = append(addresses, addr)
+	}
+
+	logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: addresses,
+	})
+	if err != nil {
+		return err
+	}
+
+	tx, err := ix.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		if err := ix.apply(ctx, tx, log); err != nil {
+			return fmt.Errorf("failed to apply log %d of tx %s: %w", log.Index, log.TxHash.Hex(), err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO checkpoint (id, block_number) VALUES (1, ?)
+		 ON CONFLICT (id) DO UPDATE SET block_number = excluded.block_number`, to); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (ix *Indexer) apply(ctx context.Context, tx *sql.Tx, log types.Log) error {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	if log.Address == ix.cfg.Registry {
+		return ix.applyRegistryLog(ctx, tx, log)
+	}
+	if parse, ok := ix.transfers[log.Address]; ok {
+		return ix.applyTransfer(ctx, tx, parse, log)
+	}
+	return nil
+}
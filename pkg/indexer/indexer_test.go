@@ -0,0 +1,226 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/vehicleId"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ Backend = simulated.Client(nil)
+
+// recordingBackend records the block ranges of FilterLogs.
+type recordingBackend struct {
+	Backend
+
+	mu     sync.Mutex
+	ranges [][2]uint64
+}
+
+func (b *recordingBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	b.ranges = append(b.ranges, [2]uint64{q.FromBlock.Uint64(), q.ToBlock.Uint64()})
+	b.mu.Unlock()
+	return b.Backend.FilterLogs(ctx, q)
+}
+
+func (b *recordingBackend) reset() [][2]uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ranges := b.ranges
+	b.ranges = nil
+	return ranges
+}
+
+type vehicleRow struct {
+	Id, Owner, DeviceDefinitionId string
+	Burned                        bool
+}
+
+func vehicles(t *testing.T, db *sql.DB) []vehicleRow {
+	t.Helper()
+	rows, err := db.Query(`SELECT id, owner, COALESCE(device_definition_id, ''), burned FROM vehicles ORDER BY CAST(id AS INTEGER)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var out []vehicleRow
+	for rows.Next() {
+		var v vehicleRow
+		if err := rows.Scan(&v.Id, &v.Owner, &v.DeviceDefinitionId, &v.Burned); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, v)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func checkpoint(t *testing.T, ix *Indexer) uint64 {
+	t.Helper()
+	block, ok, err := ix.Checkpoint(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("no checkpoint")
+	}
+	return block
+}
+
+func open(t *testing.T, dsn string) *sql.DB {
+	t.Helper()
+	db, err := Open(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	proxy := c.DeployEmitter(t)
+	start := c.Head(t)
+
+	owner := common.HexToAddress("0x1")
+	buyer := common.HexToAddress("0x2")
+	manufacturer := big.NewInt(137)
+	mintVehicle := func(id int64, dd string) {
+		c.EmitEvent(t, registry, "VehicleNodeMintedWithDeviceDefinition", manufacturer, big.NewInt(id), owner, dd)
+	}
+
+	c.EmitEvent(t, registry, "ManufacturerNodeMinted", "Ford", manufacturer, owner)
+	c.Commit()
+	mintVehicle(1, "ford_bronco_2022")
+	c.Mine(t, 3)
+	mintVehicle(2, "ford_f150_2021")
+	c.EmitEvent(t, registry, "VehicleAttributeSet", big.NewInt(2), "Make", "Ford")
+	c.Mine(t, 4)
+	transfer, err := vehicleId.VehicleIdMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics, data := simtest.EventLog(t, transfer.Events["Transfer"], owner, buyer, big.NewInt(2))
+	c.Emit(t, proxy, topics, data)
+	head := c.Mine(t, 1)
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "identity.db")
+	db := open(t, dsn)
+	backend := &recordingBackend{Backend: c.Client}
+	cfg := Config{
+		Registry:      registry,
+		StartBlock:    start,
+		ChunkSize:     3,
+		Confirmations: 1,
+		PollInterval:  10 * time.Millisecond,
+		VehicleId:     proxy,
+	}
+	ix, err := New(ctx, db, backend, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Backfill across several chunks.
+	if err := ix.Backfill(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	var want [][2]uint64
+	for from := start; from <= head; from += cfg.ChunkSize {
+		want = append(want, [2]uint64{from, min(from+cfg.ChunkSize-1, head)})
+	}
+	if got := backend.reset(); !reflect.DeepEqual(got, want) {
+		t.Errorf("backfill ranges = %v, want %v", got, want)
+	}
+	if got := checkpoint(t, ix); got != head {
+		t.Errorf("checkpoint = %d, want %d", got, head)
+	}
+	if got, want := vehicles(t, db), []vehicleRow{
+		{Id: "1", Owner: owner.Hex(), DeviceDefinitionId: "ford_bronco_2022"},
+		{Id: "2", Owner: buyer.Hex(), DeviceDefinitionId: "ford_f150_2021"},
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("vehicles = %+v, want %+v", got, want)
+	}
+	var name, info string
+	if err := db.QueryRow(`SELECT name FROM manufacturers WHERE id = '137'`).Scan(&name); err != nil || name != "Ford" {
+		t.Errorf("manufacturer name = %q, %v, want Ford", name, err)
+	}
+	if err := db.QueryRow(`SELECT info FROM attributes WHERE node_type = 'vehicle' AND node_id = '2' AND attribute = 'Make'`).Scan(&info); err != nil || info != "Ford" {
+		t.Errorf("vehicle attribute = %q, %v, want Ford", info, err)
+	}
+
+	// Follow the head, Config.Confirmations behind it.
+	mintVehicle(3, "ford_ranger_2020")
+	c.EmitEvent(t, registry, "VehicleNodeBurned", big.NewInt(1), owner)
+	c.Commit()
+	mintVehicle(4, "ford_focus_2018")
+	head = c.Mine(t, 1)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- ix.Run(runCtx) }()
+	deadline := time.Now().Add(10 * time.Second)
+	for checkpoint(t, ix) < head-cfg.Confirmations {
+		if time.Now().After(deadline) {
+			t.Fatalf("checkpoint %d did not reach %d", checkpoint(t, ix), head-cfg.Confirmations)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() = %v, want %v", err, context.Canceled)
+	}
+	if got := checkpoint(t, ix); got != head-cfg.Confirmations {
+		t.Errorf("checkpoint = %d, want %d", got, head-cfg.Confirmations)
+	}
+	if got, want := vehicles(t, db), []vehicleRow{
+		{Id: "1", Owner: owner.Hex(), Burned: true},
+		{Id: "2", Owner: buyer.Hex(), DeviceDefinitionId: "ford_f150_2021"},
+		{Id: "3", Owner: owner.Hex(), DeviceDefinitionId: "ford_ranger_2020"},
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("vehicles = %+v, want %+v", got, want)
+	}
+
+	// Restart from the checkpoint with a new connection.
+	last := checkpoint(t, ix)
+	db.Close()
+	db = open(t, dsn)
+	backend.reset()
+	if ix, err = New(ctx, db, backend, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := checkpoint(t, ix); got != last {
+		t.Fatalf("checkpoint after restart = %d, want %d", got, last)
+	}
+	if err := ix.Backfill(ctx, head); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := backend.reset(), [][2]uint64{{last + 1, head}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ranges after restart = %v, want %v", got, want)
+	}
+	if got, want := vehicles(t, db), []vehicleRow{
+		{Id: "1", Owner: owner.Hex(), Burned: true},
+		{Id: "2", Owner: buyer.Hex(), DeviceDefinitionId: "ford_f150_2021"},
+		{Id: "3", Owner: owner.Hex(), DeviceDefinitionId: "ford_ranger_2020"},
+		{Id: "4", Owner: owner.Hex(), DeviceDefinitionId: "ford_focus_2018"},
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("vehicles after restart = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+-- Identity graph rebuilt from DIMORegistry events. Token ids are stored as
+-- decimal strings since they are uint256 on-chain.
+
+CREATE TABLE IF NOT EXISTS checkpoint (
+    id           INTEGER PRIMARY KEY CHECK (id = 1),
+    block_number INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS manufacturers (
+    id       TEXT PRIMARY KEY,
+    name     TEXT NOT NULL,
+    owner    TEXT NOT NULL,
+    table_id TEXT
+);
+
+CREATE TABLE IF NOT EXISTS vehicles (
+    id                   TEXT PRIMARY KEY,
+    manufacturer_id      TEXT NOT NULL,
+    owner                TEXT NOT NULL,
+    device_definition_id TEXT,
+    storage_node_id      TEXT,
+    burned               INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS vehicles_manufacturer_id ON vehicles (manufacturer_id);
+
+CREATE TABLE IF NOT EXISTS aftermarket_devices (
+    id              TEXT PRIMARY KEY,
+    manufacturer_id TEXT NOT NULL,
+    address         TEXT NOT NULL,
+    owner           TEXT NOT NULL,
+    claimed         INTEGER NOT NULL DEFAULT 0,
+    burned          INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS aftermarket_devices_manufacturer_id ON aftermarket_devices (manufacturer_id);
+
+CREATE TABLE IF NOT EXISTS synthetic_devices (
+    id            TEXT PRIMARY KEY,
+    connection_id TEXT NOT NULL,
+    vehicle_id    TEXT NOT NULL,
+    address       TEXT NOT NULL,
+    owner         TEXT NOT NULL,
+    burned        INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS synthetic_devices_vehicle_id ON synthetic_devices (vehicle_id);
+
+CREATE TABLE IF NOT EXISTS pairings (
+    aftermarket_device_id TEXT PRIMARY KEY,
+    vehicle_id            TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS attributes (
+    node_type TEXT NOT NULL,
+    node_id   TEXT NOT NULL,
+    attribute TEXT NOT NULL,
+    info      TEXT NOT NULL,
+    PRIMARY KEY (node_type, node_id, attribute)
+);
+
+CREATE TABLE IF NOT EXISTS vehicle_streams (
+    vehicle_id TEXT PRIMARY KEY,
+    stream_id  TEXT NOT NULL
+);
@@ -0,0 +1,109 @@
+// Package logscan replays DIMORegistry events over a block range, requesting
+// the logs in chunks to stay under the node's eth_getLogs limits.
+package logscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+const defaultChunkSize = 2000
+
+// Backend is the chain access needed by Scan.
+type Backend interface {
+	ethereum.BlockNumberReader
+	ethereum.LogFilterer
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// Range is the block range of a scan. Packages replaying events embed it in
+// their Config.
+type Range struct {
+	// FromBlock is the first block to read, usually the registry deployment
+	// block.
+	FromBlock uint64
+	// ToBlock is the last block to read, the head if zero.
+	ToBlock uint64
+	// ChunkSize is the number of blocks requested per eth_getLogs call.
+	// Defaults to 2000.
+	ChunkSize uint64
+}
+
+// Resolve returns r with the default ChunkSize and, if ToBlock is zero, the
+// current head as ToBlock.
+func (r Range) Resolve(ctx context.Context, backend ethereum.BlockNumberReader) (Range, error) {
+	if r.ChunkSize == 0 {
+		r.ChunkSize = defaultChunkSize
+	}
+	if r.ToBlock == 0 {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return r, err
+		}
+		r.ToBlock = head
+	}
+	return r, nil
+}
+
+// Scan calls fn with the registry events named in events, such as
+// "ModuleAdded", in log order. Removed logs are skipped.
+func Scan(ctx context.Context, backend Backend, registry common.Address, r Range, events []string, fn func(contracts.RegistryEvent) error) error {
+	r, err := r.Resolve(ctx, backend)
+	if err != nil {
+		return err
+	}
+
+	topics, err := contracts.RegistryEventTopics()
+	if err != nil {
+		return err
+	}
+	selected := make([]common.Hash, len(events))
+	for i, name := range events {
+		topic, ok := topics[name]
+		if !ok {
+			return fmt.Errorf("logscan: unknown registry event %s", name)
+		}
+		selected[i] = topic
+	}
+	filterer, err := contracts.NewRegistryFilterer(registry, nil)
+	if err != nil {
+		return err
+	}
+
+	for from := r.FromBlock; from <= r.ToBlock; from += r.ChunkSize {
+		to := from + r.ChunkSize - 1
+		if to > r.ToBlock {
+			to = r.ToBlock
+		}
+		logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{registry},
+			Topics:    [][]common.Hash{selected},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get logs in blocks %d-%d: %w", from, to, err)
+		}
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			ev, err := filterer.ParseLog(log)
+			if err != nil {
+				return fmt.Errorf("failed to decode log %d of tx %s: %w", log.Index, log.TxHash.Hex(), err)
+			}
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package logscan
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ Backend = simulated.Client(nil)
+
+// recordingBackend records the block ranges of FilterLogs and can mark the
+// logs as removed.
+type recordingBackend struct {
+	Backend
+	removed bool
+	ranges  [][2]uint64
+}
+
+func (b *recordingBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.ranges = append(b.ranges, [2]uint64{q.FromBlock.Uint64(), q.ToBlock.Uint64()})
+	logs, err := b.Backend.FilterLogs(ctx, q)
+	for i := range logs {
+		logs[i].Removed = b.removed
+	}
+	return logs, err
+}
+
+func TestScan(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	other := c.DeployEmitter(t)
+	from := c.Head(t) + 1
+
+	c.EmitEvent(t, registry, "ModuleAdded", common.Address{1}, [][4]byte{{1}})
+	c.EmitEvent(t, registry, "VehicleNodeMinted", big.NewInt(1), big.NewInt(2), common.Address{2})
+	c.EmitEvent(t, other, "ModuleAdded", common.Address{3}, [][4]byte{{3}})
+	c.Commit()
+	c.Mine(t, 2)
+	c.EmitEvent(t, registry, "ModuleRemoved", common.Address{1}, [][4]byte{{1}})
+	c.Commit()
+	head := c.Head(t)
+
+	events := []string{"ModuleAdded", "ModuleRemoved"}
+	for _, tt := range []struct {
+		name       string
+		removed    bool
+		r          Range
+		wantEvents []string
+		wantRanges [][2]uint64
+	}{
+		{
+			name:       "chunks",
+			r:          Range{FromBlock: from, ToBlock: head, ChunkSize: 3},
+			wantEvents: []string{"ModuleAdded", "ModuleRemoved"},
+			wantRanges: [][2]uint64{{from, from + 2}, {from + 3, head}},
+		},
+		{
+			name:       "head",
+			r:          Range{FromBlock: from},
+			wantEvents: []string{"ModuleAdded", "ModuleRemoved"},
+			wantRanges: [][2]uint64{{from, head}},
+		},
+		{
+			name:       "to block",
+			r:          Range{FromBlock: from, ToBlock: from, ChunkSize: 3},
+			wantEvents: []string{"ModuleAdded"},
+			wantRanges: [][2]uint64{{from, from}},
+		},
+		{
+			name:       "removed",
+			removed:    true,
+			r:          Range{FromBlock: from},
+			wantRanges: [][2]uint64{{from, head}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &recordingBackend{Backend: c.Client, removed: tt.removed}
+			var got []string
+			err := Scan(ctx, backend, registry, tt.r, events, func(ev contracts.RegistryEvent) error {
+				got = append(got, ev.EventName())
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.wantEvents) {
+				t.Errorf("events = %v, want %v", got, tt.wantEvents)
+			}
+			if !reflect.DeepEqual(backend.ranges, tt.wantRanges) {
+				t.Errorf("ranges = %v, want %v", backend.ranges, tt.wantRanges)
+			}
+		})
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	c.EmitEvent(t, registry, "ModuleAdded", common.Address{1}, [][4]byte{{1}})
+	c.EmitEvent(t, registry, "ModuleAdded", common.Address{2}, [][4]byte{{2}})
+	c.Commit()
+
+	noop := func(contracts.RegistryEvent) error { return nil }
+	if err := Scan(ctx, c.Client, registry, Range{}, []string{"NoSuchEvent"}, noop); err == nil {
+		t.Error("Scan() of an unknown event succeeded")
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err := Scan(ctx, c.Client, registry, Range{}, []string{"ModuleAdded"}, func(contracts.RegistryEvent) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("Scan() = %v after %d calls, want %v after 1", err, calls, stop)
+	}
+}
@@ -0,0 +1,54 @@
+package simtest
+
+import "github.com/ethereum/go-ethereum/core/vm"
+
+// emitterCode returns the creation code of the log emitter. Its calldata is
+// one byte with the number of topics n, then n 32-byte topics, then the log
+// data:
+//
+//	n := calldata[0] >> 248
+//	off := 1 + 32n
+//	mem[0:] = calldata[off:]
+//	LOGn(0, len(calldata)-off, calldata[1:33], ...)
+func emitterCode() []byte {
+	prologue := []byte{
+		byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0xf8, byte(vm.SHR), // n
+		byte(vm.DUP1), byte(vm.PUSH1), 5, byte(vm.SHL), byte(vm.PUSH1), 1, byte(vm.ADD), // off, n
+		byte(vm.DUP1), byte(vm.CALLDATASIZE), byte(vm.SUB), // len, off, n
+		byte(vm.DUP1), byte(vm.DUP3), byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY), // len, off, n
+		byte(vm.SWAP1), byte(vm.POP), // len, n
+	}
+
+	// One block per topic count, each jumped to from the dispatch table.
+	var blocks [5][]byte
+	for n := range blocks {
+		b := []byte{byte(vm.JUMPDEST)}
+		for i := n - 1; i >= 0; i-- {
+			b = append(b, byte(vm.PUSH1), byte(1+32*i), byte(vm.CALLDATALOAD), byte(vm.SWAP1))
+		}
+		blocks[n] = append(b, byte(vm.PUSH1), 0, byte(vm.LOG0)+byte(n), byte(vm.STOP))
+	}
+
+	const dispatchSize = len(blocks) * 8
+	revert := []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+	dest := len(prologue) + dispatchSize + len(revert)
+	runtime := prologue
+	for n := range blocks {
+		runtime = append(runtime,
+			byte(vm.DUP2), byte(vm.PUSH1), byte(n), byte(vm.EQ),
+			byte(vm.PUSH2), byte(dest>>8), byte(dest), byte(vm.JUMPI))
+		dest += len(blocks[n])
+	}
+	runtime = append(runtime, revert...)
+	for _, b := range blocks {
+		runtime = append(runtime, b...)
+	}
+
+	// Copy the runtime code to memory and return it.
+	const creationSize = 12
+	creation := []byte{
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), creationSize, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(runtime)), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	return append(creation, runtime...)
+}
@@ -0,0 +1,181 @@
+// Package simtest runs a simulated chain for tests, with contracts that emit
+// arbitrary logs standing in for the registry and the NFT proxies.
+package simtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// ChainID is the chain id of the simulated backend.
+var ChainID = params.AllDevChainProtocolChanges.ChainID
+
+// Chain is a simulated chain with a funded account.
+type Chain struct {
+	*simulated.Backend
+	Client simulated.Client
+	Key    *ecdsa.PrivateKey
+	From   common.Address
+}
+
+// New starts a simulated chain that is closed at the end of the test.
+func New(t testing.TB) *Chain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	})
+	t.Cleanup(func() { backend.Close() })
+	return &Chain{Backend: backend, Client: backend.Client(), Key: key, From: from}
+}
+
+// Head returns the number of the latest block.
+func (c *Chain) Head(t testing.TB) uint64 {
+	t.Helper()
+	head, err := c.Client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return head
+}
+
+// Mine commits n empty blocks and returns the new head.
+func (c *Chain) Mine(t testing.TB, n int) uint64 {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		c.Commit()
+	}
+	return c.Head(t)
+}
+
+// Send signs and sends a transaction from the funded account to to, which is
+// a contract creation if nil. It is mined by the next Commit.
+func (c *Chain) Send(t testing.TB, to *common.Address, data []byte) *types.Transaction {
+	t.Helper()
+	ctx := context.Background()
+	nonce, err := c.Client.PendingNonceAt(ctx, c.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := c.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tip := big.NewInt(params.GWei)
+	tx, err := types.SignNewTx(c.Key, types.LatestSignerForChainID(ChainID), &types.DynamicFeeTx{
+		ChainID:   ChainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       1_000_000,
+		To:        to,
+		Data:      data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// Receipt returns the receipt of a mined transaction and fails the test if
+// it reverted.
+func (c *Chain) Receipt(t testing.TB, tx *types.Transaction) *types.Receipt {
+	t.Helper()
+	receipt, err := c.Client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("transaction %s reverted", tx.Hash())
+	}
+	return receipt
+}
+
+// DeployEmitter deploys a contract that emits any log, see Emit, and commits
+// a block.
+func (c *Chain) DeployEmitter(t testing.TB) common.Address {
+	t.Helper()
+	tx := c.Send(t, nil, emitterCode())
+	c.Commit()
+	return c.Receipt(t, tx).ContractAddress
+}
+
+// Emit sends a transaction making the emitter at address emit a log with
+// topics and data. It is mined by the next Commit.
+func (c *Chain) Emit(t testing.TB, emitter common.Address, topics []common.Hash, data []byte) *types.Transaction {
+	t.Helper()
+	if len(topics) > 4 {
+		t.Fatalf("%d topics, at most 4 can be emitted", len(topics))
+	}
+	calldata := []byte{byte(len(topics))}
+	for _, topic := range topics {
+		calldata = append(calldata, topic[:]...)
+	}
+	return c.Send(t, &emitter, append(calldata, data...))
+}
+
+// EmitEvent makes the emitter at address emit the registry event name with
+// args, given in the order of the event inputs. It is mined by the next
+// Commit.
+func (c *Chain) EmitEvent(t testing.TB, emitter common.Address, name string, args ...interface{}) *types.Transaction {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, ok := parsed.Events[name]
+	if !ok {
+		t.Fatalf("no event %s in the registry ABI", name)
+	}
+	topics, data := EventLog(t, ev, args...)
+	return c.Emit(t, emitter, topics, data)
+}
+
+// EventLog returns the topics and data of ev emitted with args, given in the
+// order of the event inputs.
+func EventLog(t testing.TB, ev abi.Event, args ...interface{}) ([]common.Hash, []byte) {
+	t.Helper()
+	if len(args) != len(ev.Inputs) {
+		t.Fatalf("%s has %d inputs, got %d arguments", ev.Name, len(ev.Inputs), len(args))
+	}
+	topics := []common.Hash{ev.ID}
+	var (
+		values     []interface{}
+		nonIndexed abi.Arguments
+	)
+	for i, in := range ev.Inputs {
+		if !in.Indexed {
+			values = append(values, args[i])
+			nonIndexed = append(nonIndexed, in)
+			continue
+		}
+		indexed, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		topics = append(topics, indexed[0][0])
+	}
+	data, err := nonIndexed.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return topics, data
+}
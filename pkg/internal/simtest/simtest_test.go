@@ -0,0 +1,36 @@
+package simtest
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestEmit(t *testing.T) {
+	c := New(t)
+	emitter := c.DeployEmitter(t)
+
+	topics := []common.Hash{{1}, {2}, {3}, {4}}
+	for n := 0; n <= len(topics); n++ {
+		data := bytes.Repeat([]byte{byte(n)}, 40+n)
+		tx := c.Emit(t, emitter, topics[:n], data)
+		c.Commit()
+
+		logs := c.Receipt(t, tx).Logs
+		if len(logs) != 1 {
+			t.Fatalf("%d topics: %d logs, want 1", n, len(logs))
+		}
+		log := logs[0]
+		if log.Address != emitter {
+			t.Errorf("%d topics: log address = %s, want %s", n, log.Address, emitter)
+		}
+		if len(log.Topics) != n || (n > 0 && !reflect.DeepEqual(log.Topics, topics[:n])) {
+			t.Errorf("%d topics: topics = %v, want %v", n, log.Topics, topics[:n])
+		}
+		if !bytes.Equal(log.Data, data) {
+			t.Errorf("%d topics: data = %x, want %x", n, log.Data, data)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package modules rebuilds the DIMORegistry selector routing table from its
+// ModuleAdded, ModuleUpdated and ModuleRemoved events and compares it with
+// the address book and the Go bindings' ABI.
+package modules
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/addressbook"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/logscan"
+)
+
+// registryModule is the address book entry of the selectors implemented by
+// DIMORegistry itself rather than routed to a module.
+const registryModule = "DIMORegistry"
+
+// Table maps every routed selector to its implementation.
+type Table map[[4]byte]common.Address
+
+// Apply updates t with a ModuleAdded, ModuleUpdated or ModuleRemoved event.
+// Other events are ignored.
+func (t Table) Apply(ev contracts.RegistryEvent) {
+	switch ev := ev.(type) {
+	case *contracts.RegistryModuleAdded:
+		for _, sel := range ev.Selectors {
+			t[sel] = ev.ModuleAddr
+		}
+	case *contracts.RegistryModuleRemoved:
+		for _, sel := range ev.Selectors {
+			delete(t, sel)
+		}
+	case *contracts.RegistryModuleUpdated:
+		for _, sel := range ev.OldSelectors {
+			delete(t, sel)
+		}
+		for _, sel := range ev.NewSelectors {
+			t[sel] = ev.NewImplementation
+		}
+	}
+}
+
+// Backend is the chain access needed by Load.
+type Backend = logscan.Backend
+
+// Range is the block range read by Load: FromBlock, usually the registry
+// deployment block, ToBlock, the head if zero, and ChunkSize, the number of
+// blocks per eth_getLogs call, 2000 by default.
+type Range = logscan.Range
+
+// Config configures Load.
+type Config struct {
+	// Registry is the DIMORegistry address.
+	Registry common.Address
+	Range
+}
+
+// Load replays the module events of the registry into a Table.
+func Load(ctx context.Context, backend Backend, cfg Config) (Table, error) {
+	table := make(Table)
+	err := logscan.Scan(ctx, backend, cfg.Registry, cfg.Range, []string{"ModuleAdded", "ModuleUpdated", "ModuleRemoved"},
+		func(ev contracts.RegistryEvent) error {
+			table.Apply(ev)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// Entry describes a selector found in at least one of the on-chain table,
+// the address book and the ABI.
+type Entry struct {
+	Selector [4]byte
+	// Signature is the method signature in the ABI, empty if it is not in
+	// the ABI.
+	Signature string
+	// Implementation is the routed module, zero if the selector is not
+	// routed on-chain.
+	Implementation common.Address
+	// Module and BookAddress are the module listing the selector in the
+	// address book, empty if it is not listed.
+	Module      string
+	BookAddress common.Address
+}
+
+// Report lists the differences between the on-chain routing table, the
+// address book and the ABI.
+type Report struct {
+	// NotRouted are ABI methods that no module serves on-chain; calling
+	// them through the bindings reverts.
+	NotRouted []Entry
+	// NotInABI are routed selectors the bindings cannot call.
+	NotInABI []Entry
+	// NotInBook are routed selectors missing from addresses.json.
+	NotInBook []Entry
+	// NotOnChain are selectors in addresses.json that are not routed.
+	NotOnChain []Entry
+	// Mismatched are selectors routed to another module than the one in
+	// addresses.json.
+	Mismatched []Entry
+}
+
+// OK reports whether the three sources agree.
+func (r *Report) OK() bool {
+	return len(r.NotRouted)+len(r.NotInABI)+len(r.NotInBook)+len(r.NotOnChain)+len(r.Mismatched) == 0
+}
+
+// Compare diffs table against network's address book entry and the
+// registry ABI. The selectors listed under DIMORegistry in the address book
+// are served by the registry itself and count as routed to it.
+func Compare(table Table, network *addressbook.Network) (*Report, error) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[[4]byte]*Entry)
+	entry := func(sel [4]byte) *Entry {
+		e, ok := entries[sel]
+		if !ok {
+			e = &Entry{Selector: sel}
+			entries[sel] = e
+		}
+		return e
+	}
+
+	for _, method := range parsed.Methods {
+		var sel [4]byte
+		copy(sel[:], method.ID)
+		entry(sel).Signature = method.Sig
+	}
+	for sel, impl := range table {
+		entry(sel).Implementation = impl
+	}
+	for name, module := range network.Modules {
+		for _, sel := range module.Selectors {
+			e := entry(sel)
+			e.Module, e.BookAddress = name, module.Address
+			if name == registryModule {
+				e.Implementation = network.Registry
+			}
+		}
+	}
+
+	sorted := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Selector[:], sorted[j].Selector[:]) < 0
+	})
+
+	report := new(Report)
+	for _, e := range sorted {
+		routed := e.Implementation != (common.Address{})
+		inBook := e.Module != ""
+		switch {
+		case e.Signature != "" && !routed:
+			report.NotRouted = append(report.NotRouted, *e)
+		case e.Signature == "" && routed:
+			report.NotInABI = append(report.NotInABI, *e)
+		}
+		switch {
+		case routed && !inBook:
+			report.NotInBook = append(report.NotInBook, *e)
+		case !routed && inBook:
+			report.NotOnChain = append(report.NotOnChain, *e)
+		case routed && e.Implementation != e.BookAddress:
+			report.Mismatched = append(report.Mismatched, *e)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,123 @@
+package modules
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/addressbook"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var (
+	moduleA = common.HexToAddress("0xa0")
+	moduleB = common.HexToAddress("0xb0")
+	moduleC = common.HexToAddress("0xc0")
+)
+
+// methodSelector returns the selector of a registry method.
+func methodSelector(t *testing.T, name string) [4]byte {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	method, ok := parsed.Methods[name]
+	if !ok {
+		t.Fatalf("no method %s in the registry ABI", name)
+	}
+	var sel [4]byte
+	copy(sel[:], method.ID)
+	return sel
+}
+
+func TestLoad(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	from := c.Head(t) + 1
+
+	s1, s2, s3, s4 := [4]byte{1}, [4]byte{2}, [4]byte{3}, [4]byte{4}
+	c.EmitEvent(t, registry, "ModuleAdded", moduleA, [][4]byte{s1, s2})
+	c.EmitEvent(t, registry, "ModuleAdded", moduleB, [][4]byte{s3})
+	c.Commit()
+	c.EmitEvent(t, registry, "VehicleNodeMinted", big.NewInt(1), big.NewInt(2), moduleA)
+	c.EmitEvent(t, registry, "ModuleUpdated", moduleA, moduleC, [][4]byte{s1}, [][4]byte{s1, s4})
+	c.Commit()
+	c.EmitEvent(t, registry, "ModuleRemoved", moduleB, [][4]byte{s3})
+	c.Commit()
+
+	for _, r := range []Range{
+		{FromBlock: from},
+		{FromBlock: from, ChunkSize: 1},
+	} {
+		table, err := Load(context.Background(), c.Client, Config{Registry: registry, Range: r})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := Table{s1: moduleC, s2: moduleA, s4: moduleC}
+		if !reflect.DeepEqual(table, want) {
+			t.Errorf("Load() with chunks of %d = %v, want %v", r.ChunkSize, table, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	registry := common.HexToAddress("0x5eAA351EdFc8bCe8fA9DEa2fEdDD5A84cDAde96c")
+	var (
+		ok         = methodSelector(t, "setVehicleInfo")
+		notRouted  = methodSelector(t, "unsetVehicleStream")
+		notOnChain = methodSelector(t, "createVehicleStream")
+		mismatched = methodSelector(t, "setDefaultStorageNodeId")
+		native     = methodSelector(t, "addModule")
+		notInABI   = [4]byte{0xde, 0xad, 0xbe, 0xef}
+	)
+	table := Table{ok: moduleA, mismatched: moduleC, notInABI: moduleB}
+	network := &addressbook.Network{
+		Registry: registry,
+		Modules: map[string]addressbook.Module{
+			"DIMORegistry": {Address: registry, Selectors: [][4]byte{native}},
+			"Vehicle":      {Address: moduleA, Selectors: [][4]byte{ok, mismatched}},
+			"Stream":       {Address: moduleB, Selectors: [][4]byte{notOnChain}},
+		},
+	}
+
+	report, err := Compare(table, network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.OK() {
+		t.Fatal("OK() = true")
+	}
+
+	for _, tt := range []struct {
+		name    string
+		entries []Entry
+		want    map[[4]byte]bool
+	}{
+		{"NotRouted", report.NotRouted, map[[4]byte]bool{notRouted: true, notOnChain: true}},
+		{"NotInABI", report.NotInABI, map[[4]byte]bool{notInABI: true}},
+		{"NotInBook", report.NotInBook, map[[4]byte]bool{notInABI: true}},
+		{"NotOnChain", report.NotOnChain, map[[4]byte]bool{notOnChain: true}},
+		{"Mismatched", report.Mismatched, map[[4]byte]bool{mismatched: true}},
+	} {
+		got := make(map[[4]byte]bool)
+		for _, e := range tt.entries {
+			got[e.Selector] = true
+		}
+		for _, sel := range [][4]byte{ok, notRouted, notOnChain, mismatched, native, notInABI} {
+			if got[sel] != tt.want[sel] {
+				t.Errorf("%s has %x: %t, want %t", tt.name, sel, got[sel], tt.want[sel])
+			}
+		}
+	}
+
+	for _, e := range report.Mismatched {
+		if e.Selector == mismatched && (e.Implementation != moduleC || e.BookAddress != moduleA || e.Module != "Vehicle" || e.Signature == "") {
+			t.Errorf("mismatched entry = %+v", e)
+		}
+	}
+}
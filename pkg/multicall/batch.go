@@ -0,0 +1,74 @@
+package multicall
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// Batch queues typed registry reads to be executed by a Reader.
+type Batch struct {
+	abi   *abi.ABI
+	calls []call
+}
+
+// NewBatch returns an empty Batch.
+func NewBatch() (*Batch, error) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Batch{abi: parsed}, nil
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// GetParentNode queues getParentNode(idProxyAddress, tokenId).
+func (b *Batch) GetParentNode(idProxyAddress common.Address, tokenId *big.Int) *Call[*big.Int] {
+	return queue[*big.Int](b, "getParentNode", idProxyAddress, tokenId)
+}
+
+// GetLink queues getLink(idProxyAddress, sourceNode).
+func (b *Batch) GetLink(idProxyAddress common.Address, sourceNode *big.Int) *Call[*big.Int] {
+	return queue[*big.Int](b, "getLink", idProxyAddress, sourceNode)
+}
+
+// GetInfo queues getInfo(idProxyAddress, tokenId, attribute).
+func (b *Batch) GetInfo(idProxyAddress common.Address, tokenId *big.Int, attribute string) *Call[string] {
+	return queue[string](b, "getInfo", idProxyAddress, tokenId, attribute)
+}
+
+// IsAftermarketDeviceClaimed queues isAftermarketDeviceClaimed(nodeId).
+func (b *Batch) IsAftermarketDeviceClaimed(nodeId *big.Int) *Call[bool] {
+	return queue[bool](b, "isAftermarketDeviceClaimed", nodeId)
+}
+
+// GetVehicleStream queues getVehicleStream(vehicleId).
+func (b *Batch) GetVehicleStream(vehicleId *big.Int) *Call[string] {
+	return queue[string](b, "getVehicleStream", vehicleId)
+}
+
+// VehicleIdToStorageNodeId queues vehicleIdToStorageNodeId(vehicleId).
+func (b *Batch) VehicleIdToStorageNodeId(vehicleId *big.Int) *Call[*big.Int] {
+	return queue[*big.Int](b, "vehicleIdToStorageNodeId", vehicleId)
+}
+
+// queue packs the call right away. A packing error is reported by the call's
+// Result without sending it.
+func queue[T any](b *Batch, method string, params ...interface{}) *Call[T] {
+	c := &Call[T]{abi: b.abi, name: method, params: params}
+	data, err := b.abi.Pack(method, params...)
+	if err != nil {
+		c.fail(err)
+	} else {
+		c.data = data
+	}
+	b.calls = append(b.calls, c)
+	return c
+}
@@ -0,0 +1,210 @@
+package multicall
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+// SubCallError identifies the call that made a bundle revert. Err is the
+// registry error of the call simulated on its own, or the bundle revert if
+// the call succeeds on its own, e.g. because it depends on an earlier call
+// of the bundle.
+type SubCallError struct {
+	Index  int
+	Method string
+	Err    error
+}
+
+func (e *SubCallError) Error() string {
+	return fmt.Sprintf("multicall: call %d (%s) reverted: %v", e.Index, e.Method, e.Err)
+}
+
+func (e *SubCallError) Unwrap() error {
+	return e.Err
+}
+
+type packedCall struct {
+	method string
+	data   []byte
+}
+
+// Composer bundles registry writes into a single, atomic multiDelegateCall
+// transaction.
+type Composer struct {
+	address  common.Address
+	abi      *abi.ABI
+	backend  bind.ContractBackend
+	registry *contracts.RegistryTransactor
+	calls    []packedCall
+}
+
+// NewComposer returns an empty Composer for the registry deployed at address.
+func NewComposer(address common.Address, backend bind.ContractBackend) (*Composer, error) {
+	registry, err := contracts.NewRegistryTransactor(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Composer{address: address, abi: parsed, backend: backend, registry: registry}, nil
+}
+
+// Add packs a call to the registry method with the Solidity name method, e.g.
+// "setVehicleInfo", with the same arguments as the generated method.
+func (c *Composer) Add(method string, args ...interface{}) error {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+	c.calls = append(c.calls, packedCall{method: method, data: data})
+	return nil
+}
+
+// SetVehicleInfo adds setVehicleInfo(tokenId, attrInfo).
+func (c *Composer) SetVehicleInfo(tokenId *big.Int, attrInfo []contracts.AttributeInfoPair) error {
+	return c.Add("setVehicleInfo", tokenId, attrInfo)
+}
+
+// SetAftermarketDeviceInfo adds setAftermarketDeviceInfo(tokenId, attrInfo).
+func (c *Composer) SetAftermarketDeviceInfo(tokenId *big.Int, attrInfo []contracts.AttributeInfoPair) error {
+	return c.Add("setAftermarketDeviceInfo", tokenId, attrInfo)
+}
+
+// SetSyntheticDeviceInfo adds setSyntheticDeviceInfo(tokenId, attrInfo).
+func (c *Composer) SetSyntheticDeviceInfo(tokenId *big.Int, attrInfo []contracts.AttributeInfoPair) error {
+	return c.Add("setSyntheticDeviceInfo", tokenId, attrInfo)
+}
+
+// SetManufacturerInfo adds setManufacturerInfo(tokenId, attrInfoList).
+func (c *Composer) SetManufacturerInfo(tokenId *big.Int, attrInfoList []contracts.AttributeInfoPair) error {
+	return c.Add("setManufacturerInfo", tokenId, attrInfoList)
+}
+
+// AdminChangeParentNode adds adminChangeParentNode(newParentNode,
+// idProxyAddress, nodeIdList).
+func (c *Composer) AdminChangeParentNode(newParentNode *big.Int, idProxyAddress common.Address, nodeIdList []*big.Int) error {
+	return c.Add("adminChangeParentNode", newParentNode, idProxyAddress, nodeIdList)
+}
+
+// AdminSetVehicleDDs adds adminSetVehicleDDs(vehicleIdDdId).
+func (c *Composer) AdminSetVehicleDDs(vehicleIdDdId []contracts.DevAdminVehicleIdDeviceDefinitionId) error {
+	return c.Add("adminSetVehicleDDs", vehicleIdDdId)
+}
+
+// AdminPairAftermarketDevice adds adminPairAftermarketDevice(
+// aftermarketDeviceNode, vehicleNode).
+func (c *Composer) AdminPairAftermarketDevice(aftermarketDeviceNode, vehicleNode *big.Int) error {
+	return c.Add("adminPairAftermarketDevice", aftermarketDeviceNode, vehicleNode)
+}
+
+// SetStorageNodeIdForVehicle adds setStorageNodeIdForVehicle(vehicleId,
+// storageNodeId).
+func (c *Composer) SetStorageNodeIdForVehicle(vehicleId, storageNodeId *big.Int) error {
+	return c.Add("setStorageNodeIdForVehicle", vehicleId, storageNodeId)
+}
+
+// SetVehicleStream adds setVehicleStream(vehicleId, streamId).
+func (c *Composer) SetVehicleStream(vehicleId *big.Int, streamId string) error {
+	return c.Add("setVehicleStream", vehicleId, streamId)
+}
+
+// Len returns the number of calls in the bundle.
+func (c *Composer) Len() int {
+	return len(c.calls)
+}
+
+// Calldata returns the packed calls, as passed to multiDelegateCall.
+func (c *Composer) Calldata() [][]byte {
+	data := make([][]byte, len(c.calls))
+	for i, call := range c.calls {
+		data[i] = call.data
+	}
+	return data
+}
+
+// EstimateGas estimates the gas of the bundle sent by from. If the bundle
+// reverts, the error is a *SubCallError naming the failing call.
+func (c *Composer) EstimateGas(ctx context.Context, from common.Address) (uint64, error) {
+	input, err := c.abi.Pack("multiDelegateCall", c.Calldata())
+	if err != nil {
+		return 0, err
+	}
+	gas, err := c.backend.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &c.address, Data: input})
+	if err != nil {
+		return 0, c.diagnose(ctx, from, err)
+	}
+	return gas, nil
+}
+
+// Send sends the bundle. If the gas estimation reverts, the error is a
+// *SubCallError naming the failing call.
+func (c *Composer) Send(opts *bind.TransactOpts) (*types.Transaction, error) {
+	tx, err := c.registry.MultiDelegateCall(opts, c.Calldata())
+	if err != nil {
+		ctx := opts.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		return nil, c.diagnose(ctx, opts.From, err)
+	}
+	return tx, nil
+}
+
+// diagnose looks for the first call of the bundle that reverts by simulating
+// ever longer prefixes of the bundle, using a binary search since every
+// prefix longer than a reverting one also reverts.
+func (c *Composer) diagnose(ctx context.Context, from common.Address, bundleErr error) error {
+	if !isExecutionError(bundleErr) {
+		return bundleErr
+	}
+
+	lo, hi := 1, len(c.calls)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		err := c.simulate(ctx, from, c.calls[:mid])
+		if err != nil && !isExecutionError(err) {
+			return err
+		}
+		if err != nil {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	if lo > len(c.calls) {
+		return registryerrors.FromError(bundleErr)
+	}
+
+	failing := c.calls[lo-1]
+	callErr := registryerrors.FromError(bundleErr)
+	_, err := c.backend.CallContract(ctx, ethereum.CallMsg{From: from, To: &c.address, Data: failing.data}, nil)
+	if err != nil && isExecutionError(err) {
+		callErr = registryerrors.FromError(err)
+	}
+	return &SubCallError{Index: lo - 1, Method: failing.method, Err: callErr}
+}
+
+func (c *Composer) simulate(ctx context.Context, from common.Address, calls []packedCall) error {
+	data := make([][]byte, len(calls))
+	for i, call := range calls {
+		data[i] = call.data
+	}
+	input, err := c.abi.Pack("multiDelegateCall", data)
+	if err != nil {
+		return err
+	}
+	_, err = c.backend.CallContract(ctx, ethereum.CallMsg{From: from, To: &c.address, Data: input}, nil)
+	return err
+}
@@ -0,0 +1,177 @@
+package multicall
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+// fakeBackend runs transactions on a fakeRegistry and records those sent.
+type fakeBackend struct {
+	*fakeRegistry
+	sent []*types.Transaction
+}
+
+func (b *fakeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1e9)}, nil
+}
+
+func (b *fakeBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (b *fakeBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return uint64(len(b.sent)), nil
+}
+
+func (b *fakeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	if _, err := b.CallContract(ctx, msg, nil); err != nil {
+		return 0, err
+	}
+	return 21_000 + uint64(len(msg.Data))*16, nil
+}
+
+func (b *fakeBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeBackend) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, ethereum.NotFound
+}
+
+func newComposer(t *testing.T) (*Composer, *fakeBackend) {
+	t.Helper()
+	backend := &fakeBackend{fakeRegistry: newFakeRegistry(t)}
+	c, err := NewComposer(testRegistry, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, backend
+}
+
+func attrInfo() []contracts.AttributeInfoPair {
+	return []contracts.AttributeInfoPair{{Attribute: "Make", Info: "Ford"}}
+}
+
+func TestComposerSend(t *testing.T) {
+	c, backend := newComposer(t)
+	if err := c.SetVehicleInfo(big.NewInt(1), attrInfo()); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetStorageNodeIdForVehicle(big.NewInt(1), big.NewInt(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("noSuchMethod"); err == nil {
+		t.Error("Add() of an unknown method succeeded")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", c.Len())
+	}
+
+	want, err := backend.abi.Pack("setVehicleInfo", big.NewInt(1), attrInfo())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := c.Calldata()[0]; !bytes.Equal(got, want) {
+		t.Errorf("Calldata()[0] = %x, want %x", got, want)
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.EstimateGas(context.Background(), opts.From); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := c.Send(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := backend.abi.Pack("multiDelegateCall", c.Calldata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tx.Data(), bundle) || *tx.To() != testRegistry {
+		t.Errorf("sent %x to %s, want multiDelegateCall to %s", tx.Data(), tx.To(), testRegistry)
+	}
+}
+
+func TestComposerSubCallError(t *testing.T) {
+	from := common.HexToAddress("0x1")
+
+	t.Run("reverts on its own", func(t *testing.T) {
+		c, _ := newComposer(t)
+		for _, id := range []int64{1, 2, 13, 4} {
+			if err := c.SetVehicleInfo(big.NewInt(id), attrInfo()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_, err := c.EstimateGas(context.Background(), from)
+		var sub *SubCallError
+		if !errors.As(err, &sub) || sub.Index != 2 || sub.Method != "setVehicleInfo" {
+			t.Fatalf("EstimateGas() = %v, want SubCallError for call 2", err)
+		}
+		var invalid *registryerrors.InvalidNode
+		if !errors.As(err, &invalid) || invalid.TokenID.Int64() != 13 {
+			t.Errorf("SubCallError.Err = %v, want InvalidNode(13)", sub.Err)
+		}
+	})
+
+	t.Run("reverts after an earlier call", func(t *testing.T) {
+		c, _ := newComposer(t)
+		for _, storageNode := range []int64{1, 2} {
+			if err := c.SetStorageNodeIdForVehicle(big.NewInt(7), big.NewInt(storageNode)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_, err := c.EstimateGas(context.Background(), from)
+		var sub *SubCallError
+		if !errors.As(err, &sub) || sub.Index != 1 || sub.Method != "setStorageNodeIdForVehicle" {
+			t.Fatalf("EstimateGas() = %v, want SubCallError for call 1", err)
+		}
+		// The call succeeds on its own, so the error is the bundle revert.
+		var revert *registryerrors.Revert
+		if !errors.As(err, &revert) || revert.Reason != "Delegate call failed" {
+			t.Errorf("SubCallError.Err = %v, want the bundle revert", sub.Err)
+		}
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		c, backend := newComposer(t)
+		if err := c.SetVehicleInfo(big.NewInt(13), attrInfo()); err != nil {
+			t.Fatal(err)
+		}
+		backend.err = errors.New("connection refused")
+		if _, err := c.EstimateGas(context.Background(), from); !errors.Is(err, backend.err) {
+			t.Fatalf("EstimateGas() = %v, want %v", err, backend.err)
+		}
+	})
+}
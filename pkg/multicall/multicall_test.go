@@ -0,0 +1,159 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var (
+	testRegistry = common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c")
+	testProxy    = common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF")
+)
+
+// revertError is an RPC error carrying revert data.
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// fakeRegistry executes a few registry methods, and multiStaticCall and
+// multiDelegateCall over them. Node 13 does not exist.
+type fakeRegistry struct {
+	t   *testing.T
+	abi *abi.ABI
+
+	// gasCap is the maximum number of calls of a multiStaticCall, unlimited
+	// if zero.
+	gasCap int
+	// err fails every call, as a transport error would.
+	err error
+
+	mu         sync.Mutex
+	multicalls []int
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeRegistry{t: t, abi: parsed}
+}
+
+// sizes returns the number of calls of every multiStaticCall so far.
+func (r *fakeRegistry) sizes() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int(nil), r.multicalls...)
+}
+
+func (r *fakeRegistry) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (r *fakeRegistry) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.call(msg.Data, make(map[string]bool))
+}
+
+// call executes data against state, the storage written by earlier calls of
+// the same transaction.
+func (r *fakeRegistry) call(data []byte, state map[string]bool) ([]byte, error) {
+	method, err := r.abi.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "multiStaticCall", "multiDelegateCall":
+		calls := args[0].([][]byte)
+		reason := "Delegate call failed"
+		if method.Name == "multiStaticCall" {
+			reason = "Static call failed"
+			r.mu.Lock()
+			r.multicalls = append(r.multicalls, len(calls))
+			r.mu.Unlock()
+			if r.gasCap > 0 && len(calls) > r.gasCap {
+				return nil, errors.New("gas required exceeds allowance (30000000)")
+			}
+		}
+		results := make([][]byte, len(calls))
+		for i, c := range calls {
+			if results[i], err = r.call(c, state); err != nil {
+				return nil, r.revertString(reason)
+			}
+		}
+		return method.Outputs.Pack(results)
+
+	case "getParentNode":
+		id := args[1].(*big.Int)
+		if id.Int64() == 13 {
+			return nil, r.invalidNode(args[0].(common.Address), id)
+		}
+		return method.Outputs.Pack(new(big.Int).Add(id, big.NewInt(100)))
+
+	case "getInfo":
+		return method.Outputs.Pack(fmt.Sprintf("%s of %s", args[2], args[1]))
+
+	case "setVehicleInfo":
+		if id := args[0].(*big.Int); id.Int64() == 13 {
+			return nil, r.invalidNode(testProxy, id)
+		}
+		return nil, nil
+
+	case "setStorageNodeIdForVehicle":
+		key := args[0].(*big.Int).String()
+		if state[key] {
+			return nil, r.revertString("storage node already set")
+		}
+		state[key] = true
+		return nil, nil
+	}
+	r.t.Errorf("unexpected call to %s", method.Name)
+	return nil, fmt.Errorf("unexpected call to %s", method.Name)
+}
+
+func (r *fakeRegistry) invalidNode(proxy common.Address, id *big.Int) error {
+	abiErr := r.abi.Errors["InvalidNode"]
+	data, err := abiErr.Inputs.Pack(proxy, id)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return &revertError{data: append(abiErr.ID[:4:4], data...)}
+}
+
+func (r *fakeRegistry) revertString(reason string) error {
+	data, err := abi.Arguments{{Type: mustType(r.t, "string")}}.Pack(reason)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return &revertError{data: append([]byte{0x08, 0xc3, 0x79, 0xa0}, data...)}
+}
+
+func mustType(t *testing.T, name string) abi.Type {
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return typ
+}
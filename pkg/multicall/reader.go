@@ -0,0 +1,182 @@
+// Package multicall batches typed DIMORegistry reads into multiStaticCall
+// requests and writes into multiDelegateCall transactions.
+package multicall
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+const defaultChunkSize = 200
+
+// ErrNotExecuted is returned by Call.Result before its batch is executed.
+var ErrNotExecuted = errors.New("multicall: batch not executed")
+
+// Reader executes batches against a DIMORegistry.
+type Reader struct {
+	caller    *contracts.RegistryCaller
+	chunkSize int
+}
+
+// NewReader returns a Reader for the registry deployed at address. chunkSize
+// is the maximum number of calls sent in one multiStaticCall, 200 if zero.
+func NewReader(address common.Address, caller bind.ContractCaller, chunkSize int) (*Reader, error) {
+	registry, err := contracts.NewRegistryCaller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	return &Reader{caller: registry, chunkSize: chunkSize}, nil
+}
+
+// Execute runs every call queued on b. Calls are sent in chunks of at most the
+// reader's chunk size. A chunk that reverts or exceeds the node's gas cap is
+// split in half and retried; a single call that still fails is retried on
+// its own so that its Result returns the decoded registry error, without
+// failing the other calls. Execute only returns transport and decoding
+// errors.
+func (r *Reader) Execute(opts *bind.CallOpts, b *Batch) error {
+	var pending []call
+	for _, c := range b.calls {
+		if !c.done() {
+			pending = append(pending, c)
+		}
+	}
+
+	for start := 0; start < len(pending); start += r.chunkSize {
+		end := start + r.chunkSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		if err := r.execute(opts, pending[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reader) execute(opts *bind.CallOpts, calls []call) error {
+	if len(calls) == 1 {
+		return r.executeSingle(opts, calls[0])
+	}
+
+	data := make([][]byte, len(calls))
+	for i, c := range calls {
+		data[i] = c.calldata()
+	}
+
+	results, err := r.caller.MultiStaticCall(opts, data)
+	if err != nil {
+		if !isExecutionError(err) {
+			return err
+		}
+		mid := len(calls) / 2
+		if err := r.execute(opts, calls[:mid]); err != nil {
+			return err
+		}
+		return r.execute(opts, calls[mid:])
+	}
+	if len(results) != len(calls) {
+		return fmt.Errorf("multicall: got %d results for %d calls", len(results), len(calls))
+	}
+
+	for i, c := range calls {
+		if err := c.unpack(results[i]); err != nil {
+			return fmt.Errorf("failed to unpack %s result: %w", c.method(), err)
+		}
+	}
+	return nil
+}
+
+// executeSingle calls c directly instead of through multiStaticCall, which
+// only reverts with "Static call failed", to surface the registry error.
+func (r *Reader) executeSingle(opts *bind.CallOpts, c call) error {
+	var out []interface{}
+	raw := &contracts.RegistryCallerRaw{Contract: r.caller}
+	err := raw.Call(opts, &out, c.method(), c.args()...)
+	if err != nil {
+		if !isExecutionError(err) {
+			return err
+		}
+		c.fail(registryerrors.FromError(err))
+		return nil
+	}
+	c.set(out)
+	return nil
+}
+
+// isExecutionError reports whether err comes from the execution of a call, a
+// revert or running out of gas, rather than from the transport.
+func isExecutionError(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "execution reverted") ||
+		strings.Contains(msg, "out of gas") ||
+		strings.Contains(msg, "gas required exceeds")
+}
+
+// call is a read queued on a Batch, independent of its result type.
+type call interface {
+	method() string
+	args() []interface{}
+	calldata() []byte
+	unpack(result []byte) error
+	set(out []interface{})
+	fail(err error)
+	done() bool
+}
+
+// Call is a read queued on a Batch. Its result is available once the batch
+// has been executed.
+type Call[T any] struct {
+	abi      *abi.ABI
+	name     string
+	params   []interface{}
+	data     []byte
+	value    T
+	err      error
+	executed bool
+}
+
+// Result returns the value read, or the error the registry reverted with.
+func (c *Call[T]) Result() (T, error) {
+	if !c.executed {
+		var zero T
+		return zero, ErrNotExecuted
+	}
+	return c.value, c.err
+}
+
+func (c *Call[T]) method() string      { return c.name }
+func (c *Call[T]) args() []interface{} { return c.params }
+func (c *Call[T]) calldata() []byte    { return c.data }
+func (c *Call[T]) done() bool          { return c.executed }
+func (c *Call[T]) fail(err error)      { c.err, c.executed = err, true }
+
+func (c *Call[T]) unpack(b []byte) error {
+	out, err := c.abi.Unpack(c.name, b)
+	if err != nil {
+		return err
+	}
+	c.set(out)
+	return nil
+}
+
+func (c *Call[T]) set(out []interface{}) {
+	c.value = *abi.ConvertType(out[0], new(T)).(*T)
+	c.executed = true
+}
@@ -0,0 +1,126 @@
+package multicall
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+func parentNodes(t *testing.T, ids ...int64) (*Batch, []*Call[*big.Int]) {
+	t.Helper()
+	b, err := NewBatch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := make([]*Call[*big.Int], len(ids))
+	for i, id := range ids {
+		calls[i] = b.GetParentNode(testProxy, big.NewInt(id))
+	}
+	return b, calls
+}
+
+func execute(t *testing.T, registry *fakeRegistry, chunkSize int, b *Batch) error {
+	t.Helper()
+	r, err := NewReader(testRegistry, registry, chunkSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.Execute(&bind.CallOpts{}, b)
+}
+
+func checkParents(t *testing.T, calls []*Call[*big.Int], ids ...int64) {
+	t.Helper()
+	for i, c := range calls {
+		got, err := c.Result()
+		if err != nil {
+			t.Errorf("call %d: %v", i, err)
+			continue
+		}
+		if want := ids[i] + 100; got.Int64() != want {
+			t.Errorf("call %d = %s, want %d", i, got, want)
+		}
+	}
+}
+
+func TestReaderExecute(t *testing.T) {
+	registry := newFakeRegistry(t)
+	b, calls := parentNodes(t, 1, 2, 3, 4, 5, 6, 7)
+	info := b.GetInfo(testProxy, big.NewInt(7), "Make")
+	if _, err := info.Result(); !errors.Is(err, ErrNotExecuted) {
+		t.Fatalf("Result() before Execute = %v, want %v", err, ErrNotExecuted)
+	}
+
+	if err := execute(t, registry, 3, b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := registry.sizes(), []int{3, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("multiStaticCall sizes = %v, want %v", got, want)
+	}
+	checkParents(t, calls, 1, 2, 3, 4, 5, 6, 7)
+	if got, err := info.Result(); err != nil || got != "Make of 7" {
+		t.Errorf("getInfo = %q, %v, want %q", got, err, "Make of 7")
+	}
+
+	// Executing again only sends the call added since, on its own.
+	more := b.GetParentNode(testProxy, big.NewInt(8))
+	if err := execute(t, registry, 3, b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := registry.sizes(), []int{3, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("multiStaticCall sizes = %v, want %v", got, want)
+	}
+	checkParents(t, []*Call[*big.Int]{more}, 8)
+}
+
+func TestReaderRevert(t *testing.T) {
+	registry := newFakeRegistry(t)
+	b, calls := parentNodes(t, 1, 2, 13, 4, 5)
+
+	if err := execute(t, registry, 10, b); err != nil {
+		t.Fatal(err)
+	}
+	// [1 2 13 4 5] reverts, [1 2] succeeds, [13 4 5] reverts, 13 is sent on
+	// its own and [4 5] succeeds.
+	if got, want := registry.sizes(), []int{5, 2, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("multiStaticCall sizes = %v, want %v", got, want)
+	}
+	checkParents(t, append(calls[:2:2], calls[3:]...), 1, 2, 4, 5)
+
+	_, err := calls[2].Result()
+	var invalid *registryerrors.InvalidNode
+	if !errors.As(err, &invalid) || invalid.TokenID.Int64() != 13 || invalid.Proxy != testProxy {
+		t.Errorf("reverting call error = %v, want InvalidNode(%s, 13)", err, testProxy)
+	}
+}
+
+func TestReaderGasCap(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.gasCap = 2
+	b, calls := parentNodes(t, 1, 2, 3, 4)
+
+	if err := execute(t, registry, 10, b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := registry.sizes(), []int{4, 2, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("multiStaticCall sizes = %v, want %v", got, want)
+	}
+	checkParents(t, calls, 1, 2, 3, 4)
+}
+
+func TestReaderTransportError(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.err = errors.New("connection refused")
+	b, calls := parentNodes(t, 1, 2)
+
+	if err := execute(t, registry, 10, b); !errors.Is(err, registry.err) {
+		t.Fatalf("Execute() = %v, want %v", err, registry.err)
+	}
+	if _, err := calls[0].Result(); !errors.Is(err, ErrNotExecuted) {
+		t.Errorf("Result() = %v, want %v", err, ErrNotExecuted)
+	}
+}
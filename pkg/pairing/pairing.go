@@ -0,0 +1,480 @@
+// Package pairing coordinates PairAftermarketDeviceSign, whose two signatures
+// come from the aftermarket device and the vehicle owner through different
+// apps and at different times.
+package pairing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/bindings/vehicleId"
+	"github.com/DIMO-Network/dimo-identity/pkg/eip712"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+const (
+	defaultTTL          = 15 * time.Minute
+	defaultPollInterval = time.Minute
+)
+
+var (
+	// ErrSessionNotFound is returned for an unknown session id.
+	ErrSessionNotFound = errors.New("pairing: session not found")
+	// ErrSessionExpired is returned when requesting or adding a signature to
+	// a session past its expiry.
+	ErrSessionExpired = errors.New("pairing: session expired")
+	// ErrSessionClosed is returned when signing a session that was already
+	// submitted or failed.
+	ErrSessionClosed = errors.New("pairing: session closed")
+	// ErrSessionNotSigned is returned by Retry for a session missing a
+	// signature.
+	ErrSessionNotSigned = errors.New("pairing: session not signed by both parties")
+	// ErrDeviceNotMinted is returned by Create for an aftermarket device
+	// without a registered address.
+	ErrDeviceNotMinted = errors.New("pairing: aftermarket device not minted")
+)
+
+// Party is one of the two signers of a session.
+type Party int
+
+const (
+	// AftermarketDevice is the device key, registered as the aftermarket
+	// device node's address.
+	AftermarketDevice Party = iota
+	// VehicleOwner is the owner of the vehicle node.
+	VehicleOwner
+)
+
+func (p Party) String() string {
+	switch p {
+	case AftermarketDevice:
+		return "aftermarket device"
+	case VehicleOwner:
+		return "vehicle owner"
+	default:
+		return fmt.Sprintf("Party(%d)", int(p))
+	}
+}
+
+// Status is the state of a session.
+type Status int
+
+const (
+	// Pending means at least one signature is missing, or the last attempt
+	// to send the pairing failed without reaching the registry, see
+	// Session.Err and Retry.
+	Pending Status = iota
+	// Submitted means the pairing transaction was sent. Following it is left
+	// to the caller, e.g. with the tracker package.
+	Submitted
+	// Failed means the registry rejected the pairing transaction, see
+	// Session.Err.
+	Failed
+	// Expired means the session was not signed by both parties in time.
+	Expired
+	// Submitting means the pairing transaction is being sent. A session
+	// left Submitting by a crash may or may not have been sent, which only
+	// the chain tells.
+	Submitting
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Submitted:
+		return "submitted"
+	case Failed:
+		return "failed"
+	case Expired:
+		return "expired"
+	case Submitting:
+		return "submitting"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// Session is a pairing waiting for, or done with, its two signatures.
+type Session struct {
+	ID                    string
+	AftermarketDeviceNode *big.Int
+	VehicleNode           *big.Int
+	// AftermarketDeviceAddr and VehicleOwner are the expected signers, read
+	// when the session was created.
+	AftermarketDeviceAddr common.Address
+	VehicleOwner          common.Address
+	AftermarketDeviceSig  []byte
+	VehicleOwnerSig       []byte
+
+	Status    Status
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// TxHash is the pairing transaction once Submitted.
+	TxHash common.Hash
+	// Err is the reason of a Failed session, or of the last failed send of
+	// a Pending one.
+	Err string
+}
+
+// Signer returns the address expected to sign for party.
+func (s *Session) Signer(party Party) common.Address {
+	if party == AftermarketDevice {
+		return s.AftermarketDeviceAddr
+	}
+	return s.VehicleOwner
+}
+
+// Signed reports whether party's signature was accepted.
+func (s *Session) Signed(party Party) bool {
+	if party == AftermarketDevice {
+		return len(s.AftermarketDeviceSig) > 0
+	}
+	return len(s.VehicleOwnerSig) > 0
+}
+
+func (s *Session) message() *eip712.PairAftermarketDeviceSign {
+	return &eip712.PairAftermarketDeviceSign{AftermarketDeviceNode: s.AftermarketDeviceNode, VehicleNode: s.VehicleNode}
+}
+
+// SignatureRequest is what a party must sign for a session.
+type SignatureRequest struct {
+	SessionID string
+	Party     Party
+	Signer    common.Address
+	TypedData apitypes.TypedData
+}
+
+// Submitter sends the pairing transaction. *submitter.Submitter satisfies
+// it.
+type Submitter interface {
+	Submit(ctx context.Context, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error)
+}
+
+// Config configures a Coordinator.
+type Config struct {
+	// Registry and VehicleId are the DIMORegistry and VehicleId proxy
+	// addresses.
+	Registry  common.Address
+	VehicleId common.Address
+	// ChainID is the chain of the registry, part of the EIP-712 domain.
+	ChainID *big.Int
+	// TTL is how long a session waits for its signatures. Defaults to 15
+	// minutes.
+	TTL time.Duration
+	// PollInterval is how often Run expires stale sessions. Defaults to one
+	// minute.
+	PollInterval time.Duration
+}
+
+// Coordinator creates sessions, checks signatures as they arrive and submits
+// the pairing once both are present. Its methods are safe for concurrent use
+// within one process; processes sharing a SQLStore must not sign the same
+// session concurrently. The lock only covers reading and updating sessions,
+// the pairing is sent after the session is stored as Submitting.
+type Coordinator struct {
+	cfg       Config
+	store     Store
+	submitter Submitter
+	registry  *contracts.Registry
+	vehicleId *vehicleId.VehicleId
+	verifier  *eip712.Verifier
+
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+// New returns a Coordinator reading the chain through backend and sending
+// the pairing transactions, from an account with the PAIR_AD_ROLE, through
+// submitter.
+func New(backend bind.ContractBackend, store Store, submitter Submitter, cfg Config) (*Coordinator, error) {
+	if cfg.TTL == 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	registry, err := contracts.NewRegistry(cfg.Registry, backend)
+	if err != nil {
+		return nil, err
+	}
+	vid, err := vehicleId.NewVehicleId(cfg.VehicleId, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Coordinator{
+		cfg:       cfg,
+		store:     store,
+		submitter: submitter,
+		registry:  registry,
+		vehicleId: vid,
+		verifier:  eip712.NewVerifier(eip712.NewDomain(cfg.ChainID, cfg.Registry), backend),
+		now:       time.Now,
+	}, nil
+}
+
+// Create starts a session pairing aftermarketDeviceNode with vehicleNode. It
+// reads the device address and the vehicle owner, which are the addresses
+// the signatures are checked against.
+func (c *Coordinator) Create(ctx context.Context, aftermarketDeviceNode, vehicleNode *big.Int) (*Session, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	adAddr, err := c.registry.GetAftermarketDeviceAddressById(opts, aftermarketDeviceNode)
+	if err != nil {
+		return nil, err
+	}
+	if adAddr == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %s", ErrDeviceNotMinted, aftermarketDeviceNode)
+	}
+	owner, err := c.vehicleId.OwnerOf(opts, vehicleNode)
+	if err != nil {
+		return nil, registryerrors.FromError(err)
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	now := c.now()
+	s := &Session{
+		ID:                    id,
+		AftermarketDeviceNode: aftermarketDeviceNode,
+		VehicleNode:           vehicleNode,
+		AftermarketDeviceAddr: adAddr,
+		VehicleOwner:          owner,
+		Status:                Pending,
+		CreatedAt:             now,
+		ExpiresAt:             now.Add(c.cfg.TTL),
+	}
+	if err := c.store.Put(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get returns the session id.
+func (c *Coordinator) Get(ctx context.Context, id string) (*Session, error) {
+	return c.store.Get(ctx, id)
+}
+
+// Request returns the PairAftermarketDeviceSign typed data party must sign
+// for session id, for eth_signTypedData_v4.
+func (c *Coordinator) Request(ctx context.Context, id string, party Party) (*SignatureRequest, error) {
+	s, err := c.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkOpen(s); err != nil {
+		return nil, err
+	}
+	return &SignatureRequest{
+		SessionID: s.ID,
+		Party:     party,
+		Signer:    s.Signer(party),
+		TypedData: eip712.TypedData(c.verifier.Domain(), s.message()),
+	}, nil
+}
+
+// Sign checks party's signature for session id and records it. Once both
+// signatures are recorded the pairing is submitted, and the returned session
+// is Submitted, Failed if the registry rejected it, or still Pending if it
+// could not be sent, to be resent with Retry. An invalid signature returns
+// eip712.ErrInvalidAdSignature or eip712.ErrInvalidOwnerSignature and leaves
+// the session unchanged.
+func (c *Coordinator) Sign(ctx context.Context, id string, party Party, sig []byte) (*Session, error) {
+	s, err := c.sign(ctx, id, party, sig)
+	if err != nil || s.Status != Submitting {
+		return s, err
+	}
+	return c.submit(ctx, s)
+}
+
+// sign records party's signature and stores the session, as Submitting if
+// it is now fully signed.
+func (c *Coordinator) sign(ctx context.Context, id string, party Party, sig []byte) (*Session, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkOpen(s); err != nil {
+		return nil, err
+	}
+
+	opErr := eip712.ErrInvalidOwnerSignature
+	if party == AftermarketDevice {
+		opErr = eip712.ErrInvalidAdSignature
+	}
+	if err := c.verifier.VerifySignature(ctx, s.Signer(party), s.message(), sig); err != nil {
+		if isSignatureError(err) {
+			return nil, opErr
+		}
+		return nil, err
+	}
+
+	if party == AftermarketDevice {
+		s.AftermarketDeviceSig = sig
+	} else {
+		s.VehicleOwnerSig = sig
+	}
+	if s.Signed(AftermarketDevice) && s.Signed(VehicleOwner) {
+		s.Status = Submitting
+	}
+	if err := c.store.Put(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Retry sends the pairing of a Pending session signed by both parties again,
+// after Sign could not send it.
+func (c *Coordinator) Retry(ctx context.Context, id string) (*Session, error) {
+	s, err := c.reserve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.submit(ctx, s)
+}
+
+// reserve marks a fully signed Pending session as Submitting.
+func (c *Coordinator) reserve(ctx context.Context, id string) (*Session, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkOpen(s); err != nil {
+		return nil, err
+	}
+	if !s.Signed(AftermarketDevice) || !s.Signed(VehicleOwner) {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotSigned, s.ID)
+	}
+	s.Status = Submitting
+	if err := c.store.Put(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// submit sends the pairing of a Submitting session and stores the outcome.
+// No other call changes a Submitting session, so the lock is not needed.
+func (c *Coordinator) submit(ctx context.Context, s *Session) (*Session, error) {
+	var sendErr error
+	tx, err := c.submitter.Submit(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		tx, err := c.registry.PairAftermarketDeviceSign(opts, s.AftermarketDeviceNode, s.VehicleNode, s.AftermarketDeviceSig, s.VehicleOwnerSig)
+		sendErr = err
+		return tx, err
+	})
+	switch {
+	case err == nil:
+		s.Status, s.TxHash, s.Err = Submitted, tx.Hash(), ""
+	case sendErr != nil && isRevert(sendErr):
+		s.Status, s.Err = Failed, registryerrors.FromError(err).Error()
+	default:
+		s.Status, s.Err = Pending, err.Error()
+	}
+	if err := c.store.Put(ctx, s); err != nil {
+		return nil, fmt.Errorf("failed to store session %s as %s: %w", s.ID, s.Status, err)
+	}
+	return s, nil
+}
+
+// ExpireStale marks the pending sessions past their expiry as Expired and
+// returns how many were.
+func (c *Coordinator) ExpireStale(ctx context.Context) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sessions, err := c.store.List(ctx, Pending)
+	if err != nil {
+		return 0, err
+	}
+	now := c.now()
+	expired := 0
+	for _, s := range sessions {
+		if now.Before(s.ExpiresAt) {
+			continue
+		}
+		s.Status = Expired
+		if err := c.store.Put(ctx, s); err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// Run expires stale sessions every PollInterval until ctx is done.
+func (c *Coordinator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.ExpireStale(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Coordinator) checkOpen(s *Session) error {
+	switch {
+	case s.Status == Expired, s.Status == Pending && !c.now().Before(s.ExpiresAt):
+		return fmt.Errorf("%w: %s", ErrSessionExpired, s.ID)
+	case s.Status != Pending:
+		return fmt.Errorf("%w: %s is %s", ErrSessionClosed, s.ID, s.Status)
+	}
+	return nil
+}
+
+// isRevert reports whether err is the registry rejecting the pairing rather
+// than a failure to reach the node.
+func isRevert(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "execution reverted")
+}
+
+// isSignatureError reports whether err is a rejected signature rather than a
+// backend error.
+func isSignatureError(err error) bool {
+	switch {
+	case errors.Is(err, eip712.ErrZeroSignatory),
+		errors.Is(err, eip712.ErrInvalidSignature),
+		errors.Is(err, eip712.ErrInvalidSignatureLength),
+		errors.Is(err, eip712.ErrInvalidSignatureS),
+		errors.Is(err, eip712.ErrSignerMismatch):
+		return true
+	}
+	return false
+}
+
+func newID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
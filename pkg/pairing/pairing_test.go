@@ -0,0 +1,493 @@
+package pairing
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/eip712"
+	"github.com/DIMO-Network/dimo-identity/pkg/indexer"
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+var (
+	testChainID   = big.NewInt(1337)
+	testRegistry  = common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c")
+	testVehicleId = common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF")
+	testAdNode    = big.NewInt(7)
+	testVehicle   = big.NewInt(11)
+)
+
+// fakeBackend answers the registry and VehicleId reads of a Coordinator and
+// records the transactions sent.
+type fakeBackend struct {
+	adAddr      common.Address
+	owner       common.Address
+	estimateErr error
+
+	mu   sync.Mutex
+	sent []*types.Transaction
+}
+
+func (b *fakeBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (b *fakeBackend) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case *msg.To == testRegistry && bytes.Equal(msg.Data[:4], crypto.Keccak256([]byte("getAftermarketDeviceAddressById(uint256)"))[:4]):
+		return parsed.Methods["getAftermarketDeviceAddressById"].Outputs.Pack(b.adAddr)
+	case *msg.To == testVehicleId && bytes.Equal(msg.Data[:4], crypto.Keccak256([]byte("ownerOf(uint256)"))[:4]):
+		return common.LeftPadBytes(b.owner.Bytes(), 32), nil
+	}
+	// An account without code, e.g. for isValidSignature.
+	return nil, nil
+}
+
+func (b *fakeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1e9)}, nil
+}
+
+func (b *fakeBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0}, nil
+}
+
+func (b *fakeBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return 0, nil
+}
+
+func (b *fakeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1e9), nil
+}
+
+func (b *fakeBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 100_000, b.estimateErr
+}
+
+func (b *fakeBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeBackend) FilterLogs(context.Context, ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, ethereum.NotFound
+}
+
+// rpcDataError is an RPC error carrying revert data, as returned by
+// eth_estimateGas.
+type rpcDataError struct {
+	data string
+}
+
+func (e *rpcDataError) Error() string          { return "execution reverted" }
+func (e *rpcDataError) ErrorData() interface{} { return e.data }
+
+// fakeSubmitter sends through the registry bindings like
+// submitter.Submitter. Submit fails with err without sending if it is set,
+// and waits for wait to be closed first if it is set.
+type fakeSubmitter struct {
+	opts *bind.TransactOpts
+	err  error
+	wait chan struct{}
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (s *fakeSubmitter) Submit(ctx context.Context, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	s.mu.Lock()
+	s.calls++
+	nonce := s.calls
+	s.mu.Unlock()
+
+	if s.wait != nil {
+		<-s.wait
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	opts := *s.opts
+	opts.Context = ctx
+	opts.Nonce = big.NewInt(int64(nonce))
+	tx, err := send(&opts)
+	return tx, registryerrors.FromError(err)
+}
+
+func (s *fakeSubmitter) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func newKey(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+// fixture is a Coordinator over fake chain access, with the keys of both
+// parties.
+type fixture struct {
+	*Coordinator
+	backend   *fakeBackend
+	submitter *fakeSubmitter
+	adKey     *ecdsa.PrivateKey
+	ownerKey  *ecdsa.PrivateKey
+}
+
+func newFixture(t *testing.T, store Store) *fixture {
+	t.Helper()
+	adKey, adAddr := newKey(t)
+	ownerKey, owner := newKey(t)
+	senderKey, _ := newKey(t)
+	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &fakeBackend{adAddr: adAddr, owner: owner}
+	sub := &fakeSubmitter{opts: opts}
+	c, err := New(backend, store, sub, Config{Registry: testRegistry, VehicleId: testVehicleId, ChainID: testChainID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fixture{Coordinator: c, backend: backend, submitter: sub, adKey: adKey, ownerKey: ownerKey}
+}
+
+// sig signs the pairing of s with key.
+func (f *fixture) sig(t *testing.T, key *ecdsa.PrivateKey, s *Session) []byte {
+	t.Helper()
+	sig, err := eip712.Sign(key, f.verifier.Domain(), s.message())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func (f *fixture) create(t *testing.T) *Session {
+	t.Helper()
+	s, err := f.Create(context.Background(), testAdNode, testVehicle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// signBoth signs s for both parties and returns the result of the second
+// signature.
+func (f *fixture) signBoth(t *testing.T, s *Session) (*Session, error) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := f.Sign(ctx, s.ID, AftermarketDevice, f.sig(t, f.adKey, s)); err != nil {
+		t.Fatal(err)
+	}
+	return f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s))
+}
+
+// stores returns a MemoryStore and a SQLStore over a fresh database.
+func stores(t *testing.T) map[string]Store {
+	t.Helper()
+	db, err := indexer.Open("file:" + filepath.Join(t.TempDir(), "pairing.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	sqlStore, err := NewSQLStore(context.Background(), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]Store{"memory": NewMemoryStore(), "sql": sqlStore}
+}
+
+func TestSign(t *testing.T) {
+	for name, store := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t, store)
+			s := f.create(t)
+
+			req, err := f.Request(ctx, s.ID, VehicleOwner)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if req.Signer != f.backend.owner || req.TypedData.PrimaryType != "PairAftermarketDeviceSign" {
+				t.Errorf("Request() = %s for %s, want PairAftermarketDeviceSign for %s", req.TypedData.PrimaryType, req.Signer, f.backend.owner)
+			}
+
+			for _, tt := range []struct {
+				name  string
+				party Party
+				sig   []byte
+				want  error
+			}{
+				{"owner signing for the device", AftermarketDevice, f.sig(t, f.ownerKey, s), eip712.ErrInvalidAdSignature},
+				{"device signing for the owner", VehicleOwner, f.sig(t, f.adKey, s), eip712.ErrInvalidOwnerSignature},
+				{"short", AftermarketDevice, []byte{1, 2, 3}, eip712.ErrInvalidAdSignature},
+				{"other message", VehicleOwner, f.sig(t, f.ownerKey, &Session{AftermarketDeviceNode: testAdNode, VehicleNode: big.NewInt(12)}), eip712.ErrInvalidOwnerSignature},
+			} {
+				if _, err := f.Sign(ctx, s.ID, tt.party, tt.sig); !errors.Is(err, tt.want) {
+					t.Errorf("%s: Sign() = %v, want %v", tt.name, err, tt.want)
+				}
+			}
+			if got, err := f.Get(ctx, s.ID); err != nil || got.Signed(AftermarketDevice) || got.Signed(VehicleOwner) {
+				t.Fatalf("session after invalid signatures: %+v, %v", got, err)
+			}
+
+			got, err := f.Sign(ctx, s.ID, AftermarketDevice, f.sig(t, f.adKey, s))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != Pending || !got.Signed(AftermarketDevice) {
+				t.Fatalf("after the device signature: %s, signed %t", got.Status, got.Signed(AftermarketDevice))
+			}
+			got, err = f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(f.backend.sent) != 1 {
+				t.Fatalf("%d transactions sent, want 1", len(f.backend.sent))
+			}
+			tx := f.backend.sent[0]
+			if got.Status != Submitted || got.TxHash != tx.Hash() {
+				t.Errorf("Sign() = %s with tx %s, want %s with %s", got.Status, got.TxHash, Submitted, tx.Hash())
+			}
+			if sel := crypto.Keccak256([]byte("pairAftermarketDeviceSign(uint256,uint256,bytes,bytes)"))[:4]; *tx.To() != testRegistry || !bytes.Equal(tx.Data()[:4], sel) {
+				t.Errorf("sent %x to %s, want pairAftermarketDeviceSign to the registry", tx.Data()[:4], tx.To())
+			}
+			stored, err := f.Get(ctx, s.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.Status != Submitted || stored.TxHash != tx.Hash() {
+				t.Errorf("stored session = %s with tx %s", stored.Status, stored.TxHash)
+			}
+			if _, err := f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s)); !errors.Is(err, ErrSessionClosed) {
+				t.Errorf("Sign() of a submitted session = %v, want %v", err, ErrSessionClosed)
+			}
+		})
+	}
+}
+
+func TestCreateNotMinted(t *testing.T) {
+	f := newFixture(t, NewMemoryStore())
+	f.backend.adAddr = common.Address{}
+	if _, err := f.Create(context.Background(), testAdNode, testVehicle); !errors.Is(err, ErrDeviceNotMinted) {
+		t.Fatalf("Create() = %v, want %v", err, ErrDeviceNotMinted)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	for name, store := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newFixture(t, store)
+			now := time.UnixMilli(1_700_000_000_000)
+			f.now = func() time.Time { return now }
+
+			stale := f.create(t)
+			now = now.Add(defaultTTL / 2)
+			fresh := f.create(t)
+			now = now.Add(defaultTTL / 2)
+
+			if _, err := f.Request(ctx, stale.ID, AftermarketDevice); !errors.Is(err, ErrSessionExpired) {
+				t.Errorf("Request() = %v, want %v", err, ErrSessionExpired)
+			}
+			if _, err := f.Sign(ctx, stale.ID, AftermarketDevice, f.sig(t, f.adKey, stale)); !errors.Is(err, ErrSessionExpired) {
+				t.Errorf("Sign() = %v, want %v", err, ErrSessionExpired)
+			}
+			if _, err := f.Request(ctx, fresh.ID, AftermarketDevice); err != nil {
+				t.Errorf("Request() of a fresh session = %v", err)
+			}
+
+			n, err := f.ExpireStale(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 1 {
+				t.Errorf("ExpireStale() = %d, want 1", n)
+			}
+			for _, tt := range []struct {
+				s    *Session
+				want Status
+			}{{stale, Expired}, {fresh, Pending}} {
+				got, err := f.Get(ctx, tt.s.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Status != tt.want {
+					t.Errorf("session created at %s is %s, want %s", tt.s.CreatedAt, got.Status, tt.want)
+				}
+			}
+			if _, err := f.Sign(ctx, stale.ID, AftermarketDevice, f.sig(t, f.adKey, stale)); !errors.Is(err, ErrSessionExpired) {
+				t.Errorf("Sign() of an expired session = %v, want %v", err, ErrSessionExpired)
+			}
+		})
+	}
+}
+
+func TestSubmitFailure(t *testing.T) {
+	ctx := context.Background()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := parsed.Errors["AdPaired"].Inputs.Pack(testAdNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adPaired := hexutil.Encode(append(parsed.Errors["AdPaired"].ID.Bytes()[:4], args...))
+
+	t.Run("revert", func(t *testing.T) {
+		f := newFixture(t, NewMemoryStore())
+		f.backend.estimateErr = &rpcDataError{data: adPaired}
+		s, err := f.signBoth(t, f.create(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Status != Failed || !strings.Contains(s.Err, "AdPaired") {
+			t.Errorf("Sign() = %s with %q, want %s with AdPaired", s.Status, s.Err, Failed)
+		}
+		if _, err := f.Retry(ctx, s.ID); !errors.Is(err, ErrSessionClosed) {
+			t.Errorf("Retry() of a failed session = %v, want %v", err, ErrSessionClosed)
+		}
+	})
+
+	t.Run("transient", func(t *testing.T) {
+		f := newFixture(t, NewMemoryStore())
+		f.submitter.err = errors.New("connection refused")
+		s, err := f.signBoth(t, f.create(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Status != Pending || s.Err != "connection refused" {
+			t.Errorf("Sign() = %s with %q, want %s with the error", s.Status, s.Err, Pending)
+		}
+
+		f.submitter.err = nil
+		s, err = f.Retry(ctx, s.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Status != Submitted || s.Err != "" || len(f.backend.sent) != 1 {
+			t.Errorf("Retry() = %s with %q after %d sends, want %s after 1", s.Status, s.Err, len(f.backend.sent), Submitted)
+		}
+	})
+
+	t.Run("not signed", func(t *testing.T) {
+		f := newFixture(t, NewMemoryStore())
+		s := f.create(t)
+		if _, err := f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Retry(ctx, s.ID); !errors.Is(err, ErrSessionNotSigned) {
+			t.Errorf("Retry() = %v, want %v", err, ErrSessionNotSigned)
+		}
+	})
+}
+
+// TestSignSubmitsOutsideLock blocks a submit and uses the Coordinator in
+// the meantime.
+func TestSignSubmitsOutsideLock(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, NewMemoryStore())
+	f.submitter.wait = make(chan struct{})
+	s := f.create(t)
+	other := f.create(t)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := f.signBoth(t, s)
+		done <- err
+	}()
+	for f.submitter.callCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	got, err := f.Get(ctx, s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != Submitting {
+		t.Errorf("status while sending = %s, want %s", got.Status, Submitting)
+	}
+	if _, err := f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s)); !errors.Is(err, ErrSessionClosed) {
+		t.Errorf("Sign() while sending = %v, want %v", err, ErrSessionClosed)
+	}
+	if _, err := f.Sign(ctx, other.ID, AftermarketDevice, f.sig(t, f.adKey, other)); err != nil {
+		t.Errorf("Sign() of another session while sending = %v", err)
+	}
+
+	close(f.submitter.wait)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if f.submitter.callCount() != 1 {
+		t.Errorf("%d submits, want 1", f.submitter.callCount())
+	}
+}
+
+// failingStore fails to store sessions with status.
+type failingStore struct {
+	Store
+	status Status
+}
+
+func (s *failingStore) Put(ctx context.Context, session *Session) error {
+	if session.Status == s.status {
+		return errors.New("disk full")
+	}
+	return s.Store.Put(ctx, session)
+}
+
+// TestStoreFailureAfterSubmit checks that a session whose outcome could not
+// be stored is not sent again.
+func TestStoreFailureAfterSubmit(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, &failingStore{Store: NewMemoryStore(), status: Submitted})
+	s := f.create(t)
+	if _, err := f.signBoth(t, s); err == nil {
+		t.Fatal("Sign() succeeded, want the store error")
+	}
+	if _, err := f.Sign(ctx, s.ID, VehicleOwner, f.sig(t, f.ownerKey, s)); !errors.Is(err, ErrSessionClosed) {
+		t.Errorf("Sign() again = %v, want %v", err, ErrSessionClosed)
+	}
+	if _, err := f.Retry(ctx, s.ID); !errors.Is(err, ErrSessionClosed) {
+		t.Errorf("Retry() = %v, want %v", err, ErrSessionClosed)
+	}
+	if len(f.backend.sent) != 1 {
+		t.Errorf("%d transactions sent, want 1", len(f.backend.sent))
+	}
+}
@@ -0,0 +1,171 @@
+package pairing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Store persists sessions.
+type Store interface {
+	// Put inserts or replaces a session.
+	Put(ctx context.Context, s *Session) error
+	// Get returns a session, or ErrSessionNotFound.
+	Get(ctx context.Context, id string) (*Session, error)
+	// List returns the sessions with the given status.
+	List(ctx context.Context, status Status) ([]*Session, error)
+}
+
+// MemoryStore is a Store that does not survive the process. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: make(map[string]Session)}
+}
+
+func (m *MemoryStore) Put(_ context.Context, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sessions[s.ID] = *s
+	return nil
+}
+
+func (m *MemoryStore) Get(_ context.Context, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+	return &s, nil
+}
+
+func (m *MemoryStore) List(_ context.Context, status Status) ([]*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Session
+	for _, s := range m.sessions {
+		if s.Status == status {
+			s := s
+			out = append(out, &s)
+		}
+	}
+	return out, nil
+}
+
+// SQLStore is a Store backed by the pairing_sessions table of a SQLite
+// database, such as one opened with indexer.Open.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore creates the pairing_sessions table if needed and returns a
+// SQLStore.
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS pairing_sessions (
+    id                 TEXT PRIMARY KEY,
+    ad_node            TEXT NOT NULL,
+    vehicle_node       TEXT NOT NULL,
+    ad_address         TEXT NOT NULL,
+    vehicle_owner      TEXT NOT NULL,
+    ad_signature       BLOB,
+    owner_signature    BLOB,
+    status             INTEGER NOT NULL,
+    created_at         INTEGER NOT NULL,
+    expires_at         INTEGER NOT NULL,
+    tx_hash            TEXT NOT NULL,
+    error              TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS pairing_sessions_status ON pairing_sessions (status)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+	return &SQLStore{db: db}, nil
+}
+
+func (m *SQLStore) Put(ctx context.Context, s *Session) error {
+	_, err := m.db.ExecContext(ctx,
+		`INSERT INTO pairing_sessions (id, ad_node, vehicle_node, ad_address, vehicle_owner, ad_signature,
+		     owner_signature, status, created_at, expires_at, tx_hash, error)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT (id) DO UPDATE SET
+		     ad_signature = excluded.ad_signature,
+		     owner_signature = excluded.owner_signature,
+		     status = excluded.status,
+		     tx_hash = excluded.tx_hash,
+		     error = excluded.error`,
+		s.ID, s.AftermarketDeviceNode.String(), s.VehicleNode.String(), s.AftermarketDeviceAddr.Hex(), s.VehicleOwner.Hex(),
+		s.AftermarketDeviceSig, s.VehicleOwnerSig, int(s.Status), s.CreatedAt.UnixMilli(), s.ExpiresAt.UnixMilli(),
+		s.TxHash.Hex(), s.Err)
+	return err
+}
+
+const selectSessions = `SELECT id, ad_node, vehicle_node, ad_address, vehicle_owner, ad_signature, owner_signature,
+    status, created_at, expires_at, tx_hash, error FROM pairing_sessions`
+
+func (m *SQLStore) Get(ctx context.Context, id string) (*Session, error) {
+	s, err := scanSession(m.db.QueryRowContext(ctx, selectSessions+` WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+	return s, err
+}
+
+func (m *SQLStore) List(ctx context.Context, status Status) ([]*Session, error) {
+	rows, err := m.db.QueryContext(ctx, selectSessions+` WHERE status = ?`, int(status))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+func scanSession(row interface{ Scan(dest ...any) error }) (*Session, error) {
+	var (
+		s                                  Session
+		adNode, vehicleNode, adAddr, owner string
+		txHash                             string
+		status                             int
+		createdAt, expiresAt               int64
+	)
+	err := row.Scan(&s.ID, &adNode, &vehicleNode, &adAddr, &owner, &s.AftermarketDeviceSig, &s.VehicleOwnerSig,
+		&status, &createdAt, &expiresAt, &txHash, &s.Err)
+	if err != nil {
+		return nil, err
+	}
+
+	var ok bool
+	if s.AftermarketDeviceNode, ok = new(big.Int).SetString(adNode, 10); !ok {
+		return nil, fmt.Errorf("session %s: invalid aftermarket device node %q", s.ID, adNode)
+	}
+	if s.VehicleNode, ok = new(big.Int).SetString(vehicleNode, 10); !ok {
+		return nil, fmt.Errorf("session %s: invalid vehicle node %q", s.ID, vehicleNode)
+	}
+	s.AftermarketDeviceAddr = common.HexToAddress(adAddr)
+	s.VehicleOwner = common.HexToAddress(owner)
+	s.Status = Status(status)
+	s.CreatedAt = time.UnixMilli(createdAt)
+	s.ExpiresAt = time.UnixMilli(expiresAt)
+	s.TxHash = common.HexToHash(txHash)
+	return &s, nil
+}
@@ -0,0 +1,99 @@
+package pairing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestStoreParity runs the same operations against every Store.
+func TestStoreParity(t *testing.T) {
+	created := time.UnixMilli(1_700_000_000_123)
+	pending := &Session{
+		ID:                    "a",
+		AftermarketDeviceNode: big.NewInt(7),
+		VehicleNode:           new(big.Int).Lsh(big.NewInt(1), 200),
+		AftermarketDeviceAddr: common.HexToAddress("0xad"),
+		VehicleOwner:          common.HexToAddress("0x0e"),
+		AftermarketDeviceSig:  []byte{1, 2, 3},
+		Status:                Pending,
+		CreatedAt:             created,
+		ExpiresAt:             created.Add(defaultTTL),
+	}
+	submitted := &Session{
+		ID:                    "b",
+		AftermarketDeviceNode: big.NewInt(8),
+		VehicleNode:           big.NewInt(12),
+		AftermarketDeviceAddr: common.HexToAddress("0xad"),
+		VehicleOwner:          common.HexToAddress("0x0e"),
+		AftermarketDeviceSig:  []byte{1},
+		VehicleOwnerSig:       []byte{2},
+		Status:                Submitted,
+		CreatedAt:             created,
+		ExpiresAt:             created.Add(defaultTTL),
+		TxHash:                common.HexToHash("0x1234"),
+	}
+
+	for name, store := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if _, err := store.Get(ctx, "a"); !errors.Is(err, ErrSessionNotFound) {
+				t.Errorf("Get() of a missing session = %v, want %v", err, ErrSessionNotFound)
+			}
+			for _, s := range []*Session{pending, submitted} {
+				if err := store.Put(ctx, s); err != nil {
+					t.Fatal(err)
+				}
+				got, err := store.Get(ctx, s.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, s) {
+					t.Errorf("Get() =\n%+v\nwant\n%+v", got, s)
+				}
+			}
+
+			// Updates replace the signatures, status, hash and error.
+			failed := *pending
+			failed.VehicleOwnerSig = []byte{4, 5}
+			failed.Status, failed.Err = Failed, "AdPaired(tokenId: 7)"
+			if err := store.Put(ctx, &failed); err != nil {
+				t.Fatal(err)
+			}
+			got, err := store.Get(ctx, "a")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, &failed) {
+				t.Errorf("Get() after update =\n%+v\nwant\n%+v", got, &failed)
+			}
+
+			for _, tt := range []struct {
+				status Status
+				want   []string
+			}{
+				{Pending, nil},
+				{Failed, []string{"a"}},
+				{Submitted, []string{"b"}},
+				{Submitting, nil},
+			} {
+				list, err := store.List(ctx, tt.status)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var ids []string
+				for _, s := range list {
+					ids = append(ids, s.ID)
+				}
+				if !reflect.DeepEqual(ids, tt.want) {
+					t.Errorf("List(%s) = %v, want %v", tt.status, ids, tt.want)
+				}
+			}
+		})
+	}
+}
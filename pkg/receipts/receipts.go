@@ -0,0 +1,289 @@
+// Package receipts interprets the DIMORegistry logs of a transaction receipt.
+package receipts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// NodeType identifies the kind of node an entry refers to.
+type NodeType string
+
+const (
+	Manufacturer      NodeType = "manufacturer"
+	Vehicle           NodeType = "vehicle"
+	AftermarketDevice NodeType = "aftermarketDevice"
+	SyntheticDevice   NodeType = "syntheticDevice"
+)
+
+// NodeMinted is a node minted by the transaction. ParentNode is the
+// manufacturer of vehicles and aftermarket devices, and the connection of
+// synthetic devices. Name is only set for manufacturers, DeviceAddress for
+// aftermarket and synthetic devices, VehicleNode for synthetic devices and
+// DeviceDefinitionId for vehicles minted with a device definition.
+type NodeMinted struct {
+	Type               NodeType
+	TokenId            *big.Int
+	ParentNode         *big.Int
+	Owner              common.Address
+	Name               string
+	DeviceAddress      common.Address
+	VehicleNode        *big.Int
+	DeviceDefinitionId string
+	LogIndex           uint
+}
+
+// NodeBurned is a node burned by the transaction. VehicleNode is only set for
+// synthetic devices.
+type NodeBurned struct {
+	Type        NodeType
+	TokenId     *big.Int
+	Owner       common.Address
+	VehicleNode *big.Int
+	LogIndex    uint
+}
+
+// Pairing is an aftermarket device paired with or unpaired from a vehicle.
+type Pairing struct {
+	AftermarketDeviceNode *big.Int
+	VehicleNode           *big.Int
+	Owner                 common.Address
+	LogIndex              uint
+}
+
+// Claim is an aftermarket device claimed by Owner.
+type Claim struct {
+	AftermarketDeviceNode *big.Int
+	Owner                 common.Address
+	LogIndex              uint
+}
+
+// AttributeSet is an attribute-info pair set on a node.
+type AttributeSet struct {
+	Type      NodeType
+	TokenId   *big.Int
+	Attribute string
+	Info      string
+	LogIndex  uint
+}
+
+// StreamSet is a Streamr stream set on a vehicle.
+type StreamSet struct {
+	VehicleId *big.Int
+	StreamId  string
+	LogIndex  uint
+}
+
+// BatchItem correlates one element of a batch input with the nodes minted
+// for it. Fields that do not apply to the batch are nil.
+type BatchItem struct {
+	Index               int
+	ManufacturerId      *big.Int
+	VehicleId           *big.Int
+	AftermarketDeviceId *big.Int
+	SyntheticDeviceId   *big.Int
+}
+
+// Result is the interpretation of a registry transaction receipt. Every list
+// is in log order.
+type Result struct {
+	Minted        []NodeMinted
+	Burned        []NodeBurned
+	Paired        []Pairing
+	Unpaired      []Pairing
+	Claimed       []Claim
+	AttributesSet []AttributeSet
+	StreamsSet    []StreamSet
+	// Batch holds one item per element of the batch input, see Parse.
+	Batch []BatchItem
+}
+
+// Parser interprets receipts of transactions sent to a DIMORegistry.
+type Parser struct {
+	address  common.Address
+	filterer *contracts.RegistryFilterer
+	events   map[common.Hash]string
+}
+
+// NewParser returns a Parser for the registry deployed at address.
+func NewParser(address common.Address) (*Parser, error) {
+	filterer, err := contracts.NewRegistryFilterer(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(map[common.Hash]string, len(parsed.Events))
+	for name, ev := range parsed.Events {
+		events[ev.ID] = name
+	}
+	return &Parser{address: address, filterer: filterer, events: events}, nil
+}
+
+// Parse interprets the registry logs of receipt. Logs emitted by other
+// contracts, such as the NFT proxies, are ignored.
+//
+// Batch is built from the primary nodes minted, in log order, which matches
+// the order of the batch input. When vehicles are minted each one is an item
+// and synthetic devices are attached to the vehicle they were minted for.
+// Otherwise the synthetic devices, aftermarket devices or manufacturers
+// minted, in that order of precedence, are the items.
+func (p *Parser) Parse(receipt *types.Receipt) (*Result, error) {
+	res := new(Result)
+	for _, log := range receipt.Logs {
+		if log.Address != p.address || len(log.Topics) == 0 {
+			continue
+		}
+		if err := p.apply(res, log); err != nil {
+			return nil, fmt.Errorf("log %d: %w", log.Index, err)
+		}
+	}
+	res.Batch = correlate(res.Minted)
+	return res, nil
+}
+
+func (p *Parser) apply(res *Result, log *types.Log) error {
+	f := p.filterer
+	switch p.events[log.Topics[0]] {
+	case "ManufacturerNodeMinted":
+		ev, err := f.ParseManufacturerNodeMinted(*log)
+		if err != nil {
+			return err
+		}
+		res.Minted = append(res.Minted, NodeMinted{Type: Manufacturer, TokenId: ev.TokenId, Owner: ev.Owner, Name: ev.Name, LogIndex: log.Index})
+	case "VehicleNodeMinted":
+		ev, err := f.ParseVehicleNodeMinted(*log)
+		if err != nil {
+			return err
+		}
+		res.Minted = append(res.Minted, NodeMinted{Type: Vehicle, TokenId: ev.TokenId, ParentNode: ev.ManufacturerNode, Owner: ev.Owner, LogIndex: log.Index})
+	case "VehicleNodeMintedWithDeviceDefinition":
+		ev, err := f.ParseVehicleNodeMintedWithDeviceDefinition(*log)
+		if err != nil {
+			return err
+		}
+		res.Minted = append(res.Minted, NodeMinted{Type: Vehicle, TokenId: ev.VehicleId, ParentNode: ev.ManufacturerId, Owner: ev.Owner, DeviceDefinitionId: ev.DeviceDefinitionId, LogIndex: log.Index})
+	case "AftermarketDeviceNodeMinted":
+		ev, err := f.ParseAftermarketDeviceNodeMinted(*log)
+		if err != nil {
+			return err
+		}
+		res.Minted = append(res.Minted, NodeMinted{Type: AftermarketDevice, TokenId: ev.TokenId, ParentNode: ev.ManufacturerId, Owner: ev.Owner, DeviceAddress: ev.AftermarketDeviceAddress, LogIndex: log.Index})
+	case "SyntheticDeviceNodeMinted":
+		ev, err := f.ParseSyntheticDeviceNodeMinted(*log)
+		if err != nil {
+			return err
+		}
+		res.Minted = append(res.Minted, NodeMinted{Type: SyntheticDevice, TokenId: ev.SyntheticDeviceNode, ParentNode: ev.ConnectionId, Owner: ev.Owner, DeviceAddress: ev.SyntheticDeviceAddress, VehicleNode: ev.VehicleNode, LogIndex: log.Index})
+	case "VehicleNodeBurned":
+		ev, err := f.ParseVehicleNodeBurned(*log)
+		if err != nil {
+			return err
+		}
+		res.Burned = append(res.Burned, NodeBurned{Type: Vehicle, TokenId: ev.VehicleNode, Owner: ev.Owner, LogIndex: log.Index})
+	case "AftermarketDeviceNodeBurned":
+		ev, err := f.ParseAftermarketDeviceNodeBurned(*log)
+		if err != nil {
+			return err
+		}
+		res.Burned = append(res.Burned, NodeBurned{Type: AftermarketDevice, TokenId: ev.TokenId, Owner: ev.Owner, LogIndex: log.Index})
+	case "SyntheticDeviceNodeBurned":
+		ev, err := f.ParseSyntheticDeviceNodeBurned(*log)
+		if err != nil {
+			return err
+		}
+		res.Burned = append(res.Burned, NodeBurned{Type: SyntheticDevice, TokenId: ev.SyntheticDeviceNode, Owner: ev.Owner, VehicleNode: ev.VehicleNode, LogIndex: log.Index})
+	case "AftermarketDevicePaired":
+		ev, err := f.ParseAftermarketDevicePaired(*log)
+		if err != nil {
+			return err
+		}
+		res.Paired = append(res.Paired, Pairing{AftermarketDeviceNode: ev.AftermarketDeviceNode, VehicleNode: ev.VehicleNode, Owner: ev.Owner, LogIndex: log.Index})
+	case "AftermarketDeviceUnpaired":
+		ev, err := f.ParseAftermarketDeviceUnpaired(*log)
+		if err != nil {
+			return err
+		}
+		res.Unpaired = append(res.Unpaired, Pairing{AftermarketDeviceNode: ev.AftermarketDeviceNode, VehicleNode: ev.VehicleNode, Owner: ev.Owner, LogIndex: log.Index})
+	case "AftermarketDeviceClaimed":
+		ev, err := f.ParseAftermarketDeviceClaimed(*log)
+		if err != nil {
+			return err
+		}
+		res.Claimed = append(res.Claimed, Claim{AftermarketDeviceNode: ev.AftermarketDeviceNode, Owner: ev.Owner, LogIndex: log.Index})
+	case "ManufacturerAttributeSet":
+		ev, err := f.ParseManufacturerAttributeSet(*log)
+		if err != nil {
+			return err
+		}
+		res.AttributesSet = append(res.AttributesSet, AttributeSet{Type: Manufacturer, TokenId: ev.TokenId, Attribute: ev.Attribute, Info: ev.Info, LogIndex: log.Index})
+	case "VehicleAttributeSet":
+		ev, err := f.ParseVehicleAttributeSet(*log)
+		if err != nil {
+			return err
+		}
+		res.AttributesSet = append(res.AttributesSet, AttributeSet{Type: Vehicle, TokenId: ev.TokenId, Attribute: ev.Attribute, Info: ev.Info, LogIndex: log.Index})
+	case "AftermarketDeviceAttributeSet":
+		ev, err := f.ParseAftermarketDeviceAttributeSet(*log)
+		if err != nil {
+			return err
+		}
+		res.AttributesSet = append(res.AttributesSet, AttributeSet{Type: AftermarketDevice, TokenId: ev.TokenId, Attribute: ev.Attribute, Info: ev.Info, LogIndex: log.Index})
+	case "SyntheticDeviceAttributeSet":
+		ev, err := f.ParseSyntheticDeviceAttributeSet(*log)
+		if err != nil {
+			return err
+		}
+		res.AttributesSet = append(res.AttributesSet, AttributeSet{Type: SyntheticDevice, TokenId: ev.TokenId, Attribute: ev.Attribute, Info: ev.Info, LogIndex: log.Index})
+	case "VehicleStreamSet":
+		ev, err := f.ParseVehicleStreamSet(*log)
+		if err != nil {
+			return err
+		}
+		res.StreamsSet = append(res.StreamsSet, StreamSet{VehicleId: ev.VehicleId, StreamId: ev.StreamId, LogIndex: log.Index})
+	}
+	return nil
+}
+
+func correlate(minted []NodeMinted) []BatchItem {
+	byType := make(map[NodeType][]NodeMinted)
+	for _, m := range minted {
+		byType[m.Type] = append(byType[m.Type], m)
+	}
+
+	var items []BatchItem
+	switch {
+	case len(byType[Vehicle]) > 0:
+		index := make(map[string]int, len(byType[Vehicle]))
+		for i, v := range byType[Vehicle] {
+			index[v.TokenId.String()] = i
+			items = append(items, BatchItem{Index: i, ManufacturerId: v.ParentNode, VehicleId: v.TokenId})
+		}
+		for _, sd := range byType[SyntheticDevice] {
+			if i, ok := index[sd.VehicleNode.String()]; ok {
+				items[i].SyntheticDeviceId = sd.TokenId
+			}
+		}
+	case len(byType[SyntheticDevice]) > 0:
+		for i, sd := range byType[SyntheticDevice] {
+			items = append(items, BatchItem{Index: i, VehicleId: sd.VehicleNode, SyntheticDeviceId: sd.TokenId})
+		}
+	case len(byType[AftermarketDevice]) > 0:
+		for i, ad := range byType[AftermarketDevice] {
+			items = append(items, BatchItem{Index: i, ManufacturerId: ad.ParentNode, AftermarketDeviceId: ad.TokenId})
+		}
+	case len(byType[Manufacturer]) > 0:
+		for i, m := range byType[Manufacturer] {
+			items = append(items, BatchItem{Index: i, ManufacturerId: m.TokenId})
+		}
+	}
+	return items
+}
@@ -0,0 +1,158 @@
+package receipts
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var (
+	testRegistry = common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c")
+	testOwner    = common.HexToAddress("0x1")
+	testDevice   = common.HexToAddress("0x2")
+)
+
+// eventLog returns the index-th log of receipt, the registry event name
+// emitted by address with args.
+func eventLog(t *testing.T, address common.Address, index uint, name string, args ...interface{}) *types.Log {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev, ok := parsed.Events[name]
+	if !ok {
+		t.Fatalf("no event %s in the registry ABI", name)
+	}
+	log := &types.Log{Address: address, Topics: []common.Hash{ev.ID}, Index: index}
+	var data []interface{}
+	var nonIndexed abi.Arguments
+	for i, in := range ev.Inputs {
+		if !in.Indexed {
+			data = append(data, args[i])
+			nonIndexed = append(nonIndexed, in)
+			continue
+		}
+		topics, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		log.Topics = append(log.Topics, topics[0][0])
+	}
+	if log.Data, err = nonIndexed.Pack(data...); err != nil {
+		t.Fatal(err)
+	}
+	return log
+}
+
+func TestParse(t *testing.T) {
+	p, err := NewParser(testRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := common.HexToAddress("0xdead")
+	manufacturer := big.NewInt(137)
+
+	tests := []struct {
+		name string
+		logs []*types.Log
+		want *Result
+	}{
+		{
+			"vehicles and synthetic devices",
+			[]*types.Log{
+				eventLog(t, testRegistry, 0, "VehicleNodeMintedWithDeviceDefinition", manufacturer, big.NewInt(10), testOwner, "ford_bronco_2022"),
+				eventLog(t, testRegistry, 1, "VehicleAttributeSet", big.NewInt(10), "Make", "Ford"),
+				eventLog(t, testRegistry, 2, "VehicleNodeMintedWithDeviceDefinition", manufacturer, big.NewInt(11), testOwner, "ford_bronco_2023"),
+				eventLog(t, testRegistry, 3, "SyntheticDeviceNodeMinted", big.NewInt(3), big.NewInt(5), big.NewInt(11), testDevice, testOwner),
+				eventLog(t, other, 4, "VehicleNodeMintedWithDeviceDefinition", manufacturer, big.NewInt(99), testOwner, "ignored"),
+			},
+			&Result{
+				Minted: []NodeMinted{
+					{Type: Vehicle, TokenId: big.NewInt(10), ParentNode: manufacturer, Owner: testOwner, DeviceDefinitionId: "ford_bronco_2022", LogIndex: 0},
+					{Type: Vehicle, TokenId: big.NewInt(11), ParentNode: manufacturer, Owner: testOwner, DeviceDefinitionId: "ford_bronco_2023", LogIndex: 2},
+					{Type: SyntheticDevice, TokenId: big.NewInt(5), ParentNode: big.NewInt(3), Owner: testOwner, DeviceAddress: testDevice, VehicleNode: big.NewInt(11), LogIndex: 3},
+				},
+				AttributesSet: []AttributeSet{{Type: Vehicle, TokenId: big.NewInt(10), Attribute: "Make", Info: "Ford", LogIndex: 1}},
+				Batch: []BatchItem{
+					{Index: 0, ManufacturerId: manufacturer, VehicleId: big.NewInt(10)},
+					{Index: 1, ManufacturerId: manufacturer, VehicleId: big.NewInt(11), SyntheticDeviceId: big.NewInt(5)},
+				},
+			},
+		},
+		{
+			"aftermarket devices",
+			[]*types.Log{
+				eventLog(t, testRegistry, 0, "AftermarketDeviceNodeMinted", manufacturer, big.NewInt(7), testDevice, testOwner),
+				eventLog(t, testRegistry, 1, "AftermarketDeviceNodeMinted", manufacturer, big.NewInt(8), common.HexToAddress("0x3"), testOwner),
+			},
+			&Result{
+				Minted: []NodeMinted{
+					{Type: AftermarketDevice, TokenId: big.NewInt(7), ParentNode: manufacturer, Owner: testOwner, DeviceAddress: testDevice, LogIndex: 0},
+					{Type: AftermarketDevice, TokenId: big.NewInt(8), ParentNode: manufacturer, Owner: testOwner, DeviceAddress: common.HexToAddress("0x3"), LogIndex: 1},
+				},
+				Batch: []BatchItem{
+					{Index: 0, ManufacturerId: manufacturer, AftermarketDeviceId: big.NewInt(7)},
+					{Index: 1, ManufacturerId: manufacturer, AftermarketDeviceId: big.NewInt(8)},
+				},
+			},
+		},
+		{
+			"claim, pair and burn",
+			[]*types.Log{
+				eventLog(t, testRegistry, 0, "AftermarketDeviceClaimed", big.NewInt(7), testOwner),
+				eventLog(t, testRegistry, 1, "AftermarketDevicePaired", big.NewInt(7), big.NewInt(10), testOwner),
+				eventLog(t, testRegistry, 2, "VehicleNodeBurned", big.NewInt(11), testOwner),
+			},
+			&Result{
+				Claimed: []Claim{{AftermarketDeviceNode: big.NewInt(7), Owner: testOwner, LogIndex: 0}},
+				Paired:  []Pairing{{AftermarketDeviceNode: big.NewInt(7), VehicleNode: big.NewInt(10), Owner: testOwner, LogIndex: 1}},
+				Burned:  []NodeBurned{{Type: Vehicle, TokenId: big.NewInt(11), Owner: testOwner, LogIndex: 2}},
+			},
+		},
+		{
+			"manufacturers",
+			[]*types.Log{
+				eventLog(t, testRegistry, 0, "ManufacturerNodeMinted", "Ford", big.NewInt(137), testOwner),
+			},
+			&Result{
+				Minted: []NodeMinted{{Type: Manufacturer, TokenId: big.NewInt(137), Owner: testOwner, Name: "Ford", LogIndex: 0}},
+				Batch:  []BatchItem{{Index: 0, ManufacturerId: big.NewInt(137)}},
+			},
+		},
+		{
+			"no registry logs",
+			[]*types.Log{{Address: testRegistry}, {Address: other, Topics: []common.Hash{{1}}}},
+			&Result{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Parse(&types.Receipt{Logs: tt.logs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	p, err := NewParser(testRegistry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := eventLog(t, testRegistry, 3, "VehicleAttributeSet", big.NewInt(10), "Make", "Ford")
+	log.Data = log.Data[:40]
+	if _, err := p.Parse(&types.Receipt{Logs: []*types.Log{log}}); err == nil {
+		t.Fatal("Parse() of a truncated log succeeded")
+	}
+}
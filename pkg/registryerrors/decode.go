@@ -0,0 +1,174 @@
+package registryerrors
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+var (
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector  = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	stringArgs  = abi.Arguments{{Type: mustNewType("string")}}
+	uint256Args = abi.Arguments{{Type: mustNewType("uint256")}}
+)
+
+// decoders builds the typed error from the unpacked arguments of each custom
+// error, keyed by its Solidity name.
+var decoders = map[string]func(args []interface{}) error{
+	"UintUtils__InsufficientHexLength": func([]interface{}) error { return &UintUtilsInsufficientHexLength{} },
+	"AdNotClaimed":                     func(a []interface{}) error { return &AdNotClaimed{TokenID: a[0].(*big.Int)} },
+	"AdPaired":                         func(a []interface{}) error { return &AdPaired{TokenID: a[0].(*big.Int)} },
+	"AdNotPaired":                      func(a []interface{}) error { return &AdNotPaired{TokenID: a[0].(*big.Int)} },
+	"InvalidNode": func(a []interface{}) error {
+		return &InvalidNode{Proxy: a[0].(common.Address), TokenID: a[1].(*big.Int)}
+	},
+	"InvalidStorageNode":      func(a []interface{}) error { return &InvalidStorageNode{StorageNodeID: a[0].(*big.Int)} },
+	"VehiclePaired":           func(a []interface{}) error { return &VehiclePaired{TokenID: a[0].(*big.Int)} },
+	"VehicleNotPaired":        func(a []interface{}) error { return &VehicleNotPaired{TokenID: a[0].(*big.Int)} },
+	"AttributeExists":         func(a []interface{}) error { return &AttributeExists{Attribute: a[0].(string)} },
+	"AttributeNotWhitelisted": func(a []interface{}) error { return &AttributeNotWhitelisted{Attribute: a[0].(string)} },
+	"DeviceAlreadyClaimed":    func(a []interface{}) error { return &DeviceAlreadyClaimed{TokenID: a[0].(*big.Int)} },
+	"DeviceAlreadyRegistered": func(a []interface{}) error { return &DeviceAlreadyRegistered{Addr: a[0].(common.Address)} },
+	"InvalidAdSignature":      func([]interface{}) error { return &InvalidAdSignature{} },
+	"InvalidLicense":          func([]interface{}) error { return &InvalidLicense{} },
+	"InvalidOwnerSignature":   func([]interface{}) error { return &InvalidOwnerSignature{} },
+	"InvalidParentNode":       func(a []interface{}) error { return &InvalidParentNode{TokenID: a[0].(*big.Int)} },
+	"InvalidSigner":           func([]interface{}) error { return &InvalidSigner{} },
+	"OwnersDoNotMatch":        func([]interface{}) error { return &OwnersDoNotMatch{} },
+	"Unauthorized":            func(a []interface{}) error { return &Unauthorized{Addr: a[0].(common.Address)} },
+	"ZeroAddress":             func([]interface{}) error { return &ZeroAddress{} },
+	"InvalidSdSignature":      func([]interface{}) error { return &InvalidSdSignature{} },
+	"OnlyNftProxy":            func([]interface{}) error { return &OnlyNftProxy{} },
+	"NoStreamrPermission": func(a []interface{}) error {
+		return &NoStreamrPermission{User: a[0].(common.Address), PermissionType: a[1].(uint8)}
+	},
+	"StreamDoesNotExist": func(a []interface{}) error { return &StreamDoesNotExist{StreamID: a[0].(string)} },
+	"VehicleStreamAlreadySet": func(a []interface{}) error {
+		return &VehicleStreamAlreadySet{VehicleID: a[0].(*big.Int), StreamID: a[1].(string)}
+	},
+	"VehicleStreamNotSet":   func(a []interface{}) error { return &VehicleStreamNotSet{VehicleID: a[0].(*big.Int)} },
+	"ChainNotSupported":     func(a []interface{}) error { return &ChainNotSupported{ChainID: a[0].(*big.Int)} },
+	"InvalidManufacturerId": func(a []interface{}) error { return &InvalidManufacturerId{TokenID: a[0].(*big.Int)} },
+	"TableAlreadyExists":    func(a []interface{}) error { return &TableAlreadyExists{ManufacturerID: a[0].(*big.Int)} },
+	"TableDoesNotExist":     func(a []interface{}) error { return &TableDoesNotExist{TableID: a[0].(*big.Int)} },
+}
+
+// registryErrors maps the 4 byte selector of each custom error in
+// RegistryMetaData to its ABI definition.
+var registryErrors = func() map[[4]byte]abi.Error {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	errs := make(map[[4]byte]abi.Error, len(parsed.Errors))
+	for _, e := range parsed.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		errs[selector] = e
+	}
+	return errs
+}()
+
+// Decode turns a revert payload into the matching typed error. Payloads
+// encoded as Error(string) or Panic(uint256) become *Revert and *Panic, and
+// anything else becomes *Unknown. Decode returns nil for an empty payload.
+func Decode(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if len(data) < 4 {
+		return &Unknown{Data: data}
+	}
+
+	switch {
+	case bytes.Equal(data[:4], revertSelector):
+		if args, err := stringArgs.Unpack(data[4:]); err == nil {
+			return &Revert{Reason: args[0].(string)}
+		}
+		return &Unknown{Data: data}
+	case bytes.Equal(data[:4], panicSelector):
+		if args, err := uint256Args.Unpack(data[4:]); err == nil {
+			return &Panic{Code: args[0].(*big.Int)}
+		}
+		return &Unknown{Data: data}
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	abiErr, ok := registryErrors[selector]
+	if !ok {
+		return &Unknown{Data: data}
+	}
+	decode, ok := decoders[abiErr.Name]
+	if !ok {
+		return &Unknown{Data: data}
+	}
+	args, err := abiErr.Inputs.Unpack(data[4:])
+	if err != nil {
+		return &Unknown{Data: data}
+	}
+	return decode(args)
+}
+
+// FromError extracts the revert payload carried by an rpc.DataError returned
+// from a call, gas estimation or transaction sent through the Registry
+// bindings and decodes it with Decode. If err carries no revert payload it is
+// returned unchanged.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+
+	data, ok := revertData(dataErr.ErrorData())
+	if !ok {
+		return err
+	}
+	if decoded := Decode(data); decoded != nil {
+		return decoded
+	}
+	return err
+}
+
+func revertData(v interface{}) ([]byte, bool) {
+	switch data := v.(type) {
+	case []byte:
+		return data, true
+	case hexutil.Bytes:
+		return data, true
+	case string:
+		if !strings.HasPrefix(data, "0x") {
+			return nil, false
+		}
+		b, err := hexutil.Decode(data)
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	}
+	return nil, false
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
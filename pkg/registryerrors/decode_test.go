@@ -0,0 +1,165 @@
+package registryerrors
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+)
+
+// pack returns the revert payload of the registry error name with args.
+func pack(t *testing.T, name string, args ...interface{}) []byte {
+	t.Helper()
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abiErr, ok := parsed.Errors[name]
+	if !ok {
+		t.Fatalf("no error %s in the registry ABI", name)
+	}
+	data, err := abiErr.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(abiErr.ID[:4:4], data...)
+}
+
+func packWith(t *testing.T, signature string, args []byte) []byte {
+	t.Helper()
+	return append(crypto.Keccak256([]byte(signature))[:4], args...)
+}
+
+func TestDecode(t *testing.T) {
+	addr := common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF")
+	revertArgs, err := stringArgs.Pack("ECDSA: zero signatory address")
+	if err != nil {
+		t.Fatal(err)
+	}
+	panicArgs, err := uint256Args.Pack(big.NewInt(0x11))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"empty", nil, nil},
+		{"no arguments", pack(t, "InvalidOwnerSignature"), &InvalidOwnerSignature{}},
+		{"uint256", pack(t, "AdNotClaimed", big.NewInt(7)), &AdNotClaimed{TokenID: big.NewInt(7)}},
+		{"address and uint256", pack(t, "InvalidNode", addr, big.NewInt(42)), &InvalidNode{Proxy: addr, TokenID: big.NewInt(42)}},
+		{"string", pack(t, "AttributeNotWhitelisted", "Color"), &AttributeNotWhitelisted{Attribute: "Color"}},
+		{"uint8", pack(t, "NoStreamrPermission", addr, uint8(2)), &NoStreamrPermission{User: addr, PermissionType: 2}},
+		{"Error(string)", packWith(t, "Error(string)", revertArgs), &Revert{Reason: "ECDSA: zero signatory address"}},
+		{"Panic(uint256)", packWith(t, "Panic(uint256)", panicArgs), &Panic{Code: big.NewInt(0x11)}},
+		{"short", []byte{0x01, 0x02}, &Unknown{Data: []byte{0x01, 0x02}}},
+		{"unknown selector", []byte{0xde, 0xad, 0xbe, 0xef}, &Unknown{Data: []byte{0xde, 0xad, 0xbe, 0xef}}},
+		{"truncated arguments", pack(t, "AdNotClaimed", big.NewInt(7))[:20], &Unknown{Data: pack(t, "AdNotClaimed", big.NewInt(7))[:20]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Decode(tt.data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDecodersMatchABI checks that every decoder names an error of the ABI
+// with the argument types it asserts, by decoding zero values of each error.
+func TestDecodersMatchABI(t *testing.T) {
+	parsed, err := contracts.RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range decoders {
+		abiErr, ok := parsed.Errors[name]
+		if !ok {
+			t.Errorf("decoder for %s, which is not in the registry ABI", name)
+			continue
+		}
+		data, err := abiErr.Inputs.Pack(zeroArgs(t, abiErr.Inputs)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s: decoder panics: %v", name, r)
+				}
+			}()
+			if _, ok := Decode(append(abiErr.ID[:4:4], data...)).(*Unknown); ok {
+				t.Errorf("%s: decoded as Unknown", name)
+			}
+		}()
+	}
+}
+
+func zeroArgs(t *testing.T, inputs abi.Arguments) []interface{} {
+	t.Helper()
+	args := make([]interface{}, len(inputs))
+	for i, in := range inputs {
+		args[i] = reflect.New(in.Type.GetType()).Elem().Interface()
+		if args[i] == (*big.Int)(nil) {
+			args[i] = new(big.Int)
+		}
+	}
+	return args
+}
+
+type rpcDataError struct {
+	data interface{}
+}
+
+func (e *rpcDataError) Error() string          { return "execution reverted" }
+func (e *rpcDataError) ErrorData() interface{} { return e.data }
+
+func TestFromError(t *testing.T) {
+	payload := pack(t, "VehiclePaired", big.NewInt(5))
+	want := &VehiclePaired{TokenID: big.NewInt(5)}
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"hex string", &rpcDataError{data: hexutil.Encode(payload)}, want},
+		{"bytes", &rpcDataError{data: payload}, want},
+		{"wrapped", fmt.Errorf("estimate gas: %w", &rpcDataError{data: hexutil.Bytes(payload)}), want},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromError(tt.err)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromError() = %#v, want %#v", got, tt.want)
+			}
+			var paired *VehiclePaired
+			if !errors.As(got, &paired) {
+				t.Errorf("errors.As(%v, *VehiclePaired) = false", got)
+			}
+		})
+	}
+
+	for _, err := range []error{
+		errors.New("connection refused"),
+		&rpcDataError{data: "not hex"},
+		&rpcDataError{data: 42},
+	} {
+		if got := FromError(err); got != err {
+			t.Errorf("FromError(%v) = %v, want it unchanged", err, got)
+		}
+	}
+	if FromError(nil) != nil {
+		t.Error("FromError(nil) != nil")
+	}
+}
@@ -0,0 +1,294 @@
+// Package registryerrors decodes the custom errors declared in the
+// DIMORegistry ABI into typed Go errors.
+package registryerrors
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// UintUtilsInsufficientHexLength is UintUtils__InsufficientHexLength().
+type UintUtilsInsufficientHexLength struct{}
+
+func (e *UintUtilsInsufficientHexLength) Error() string {
+	return "UintUtils__InsufficientHexLength()"
+}
+
+// AdNotClaimed is AdNotClaimed(uint256 id).
+type AdNotClaimed struct {
+	TokenID *big.Int
+}
+
+func (e *AdNotClaimed) Error() string {
+	return fmt.Sprintf("AdNotClaimed(id: %s)", e.TokenID)
+}
+
+// AdPaired is AdPaired(uint256 id).
+type AdPaired struct {
+	TokenID *big.Int
+}
+
+func (e *AdPaired) Error() string {
+	return fmt.Sprintf("AdPaired(id: %s)", e.TokenID)
+}
+
+// AdNotPaired is AdNotPaired(uint256 id).
+type AdNotPaired struct {
+	TokenID *big.Int
+}
+
+func (e *AdNotPaired) Error() string {
+	return fmt.Sprintf("AdNotPaired(id: %s)", e.TokenID)
+}
+
+// InvalidNode is InvalidNode(address proxy, uint256 id).
+type InvalidNode struct {
+	Proxy   common.Address
+	TokenID *big.Int
+}
+
+func (e *InvalidNode) Error() string {
+	return fmt.Sprintf("InvalidNode(proxy: %s, id: %s)", e.Proxy.Hex(), e.TokenID)
+}
+
+// InvalidStorageNode is InvalidStorageNode(uint256 storageNodeId).
+type InvalidStorageNode struct {
+	StorageNodeID *big.Int
+}
+
+func (e *InvalidStorageNode) Error() string {
+	return fmt.Sprintf("InvalidStorageNode(storageNodeId: %s)", e.StorageNodeID)
+}
+
+// VehiclePaired is VehiclePaired(uint256 id).
+type VehiclePaired struct {
+	TokenID *big.Int
+}
+
+func (e *VehiclePaired) Error() string {
+	return fmt.Sprintf("VehiclePaired(id: %s)", e.TokenID)
+}
+
+// VehicleNotPaired is VehicleNotPaired(uint256 id).
+type VehicleNotPaired struct {
+	TokenID *big.Int
+}
+
+func (e *VehicleNotPaired) Error() string {
+	return fmt.Sprintf("VehicleNotPaired(id: %s)", e.TokenID)
+}
+
+// AttributeExists is AttributeExists(string attr).
+type AttributeExists struct {
+	Attribute string
+}
+
+func (e *AttributeExists) Error() string {
+	return fmt.Sprintf("AttributeExists(attr: %q)", e.Attribute)
+}
+
+// AttributeNotWhitelisted is AttributeNotWhitelisted(string attr).
+type AttributeNotWhitelisted struct {
+	Attribute string
+}
+
+func (e *AttributeNotWhitelisted) Error() string {
+	return fmt.Sprintf("AttributeNotWhitelisted(attr: %q)", e.Attribute)
+}
+
+// DeviceAlreadyClaimed is DeviceAlreadyClaimed(uint256 id).
+type DeviceAlreadyClaimed struct {
+	TokenID *big.Int
+}
+
+func (e *DeviceAlreadyClaimed) Error() string {
+	return fmt.Sprintf("DeviceAlreadyClaimed(id: %s)", e.TokenID)
+}
+
+// DeviceAlreadyRegistered is DeviceAlreadyRegistered(address addr).
+type DeviceAlreadyRegistered struct {
+	Addr common.Address
+}
+
+func (e *DeviceAlreadyRegistered) Error() string {
+	return fmt.Sprintf("DeviceAlreadyRegistered(addr: %s)", e.Addr.Hex())
+}
+
+// InvalidAdSignature is InvalidAdSignature().
+type InvalidAdSignature struct{}
+
+func (e *InvalidAdSignature) Error() string {
+	return "InvalidAdSignature()"
+}
+
+// InvalidLicense is InvalidLicense().
+type InvalidLicense struct{}
+
+func (e *InvalidLicense) Error() string {
+	return "InvalidLicense()"
+}
+
+// InvalidOwnerSignature is InvalidOwnerSignature().
+type InvalidOwnerSignature struct{}
+
+func (e *InvalidOwnerSignature) Error() string {
+	return "InvalidOwnerSignature()"
+}
+
+// InvalidParentNode is InvalidParentNode(uint256 id).
+type InvalidParentNode struct {
+	TokenID *big.Int
+}
+
+func (e *InvalidParentNode) Error() string {
+	return fmt.Sprintf("InvalidParentNode(id: %s)", e.TokenID)
+}
+
+// InvalidSigner is InvalidSigner().
+type InvalidSigner struct{}
+
+func (e *InvalidSigner) Error() string {
+	return "InvalidSigner()"
+}
+
+// OwnersDoNotMatch is OwnersDoNotMatch().
+type OwnersDoNotMatch struct{}
+
+func (e *OwnersDoNotMatch) Error() string {
+	return "OwnersDoNotMatch()"
+}
+
+// Unauthorized is Unauthorized(address addr). The DeviceDefinitionTable
+// module declares the same error as Unauthorized(address caller).
+type Unauthorized struct {
+	Addr common.Address
+}
+
+func (e *Unauthorized) Error() string {
+	return fmt.Sprintf("Unauthorized(addr: %s)", e.Addr.Hex())
+}
+
+// ZeroAddress is ZeroAddress().
+type ZeroAddress struct{}
+
+func (e *ZeroAddress) Error() string {
+	return "ZeroAddress()"
+}
+
+// InvalidSdSignature is InvalidSdSignature().
+type InvalidSdSignature struct{}
+
+func (e *InvalidSdSignature) Error() string {
+	return "InvalidSdSignature()"
+}
+
+// OnlyNftProxy is OnlyNftProxy().
+type OnlyNftProxy struct{}
+
+func (e *OnlyNftProxy) Error() string {
+	return "OnlyNftProxy()"
+}
+
+// NoStreamrPermission is NoStreamrPermission(address user, uint8 permissionType).
+type NoStreamrPermission struct {
+	User           common.Address
+	PermissionType uint8
+}
+
+func (e *NoStreamrPermission) Error() string {
+	return fmt.Sprintf("NoStreamrPermission(user: %s, permissionType: %d)", e.User.Hex(), e.PermissionType)
+}
+
+// StreamDoesNotExist is StreamDoesNotExist(string streamId).
+type StreamDoesNotExist struct {
+	StreamID string
+}
+
+func (e *StreamDoesNotExist) Error() string {
+	return fmt.Sprintf("StreamDoesNotExist(streamId: %q)", e.StreamID)
+}
+
+// VehicleStreamAlreadySet is VehicleStreamAlreadySet(uint256 vehicleId, string streamId).
+type VehicleStreamAlreadySet struct {
+	VehicleID *big.Int
+	StreamID  string
+}
+
+func (e *VehicleStreamAlreadySet) Error() string {
+	return fmt.Sprintf("VehicleStreamAlreadySet(vehicleId: %s, streamId: %q)", e.VehicleID, e.StreamID)
+}
+
+// VehicleStreamNotSet is VehicleStreamNotSet(uint256 vehicleId).
+type VehicleStreamNotSet struct {
+	VehicleID *big.Int
+}
+
+func (e *VehicleStreamNotSet) Error() string {
+	return fmt.Sprintf("VehicleStreamNotSet(vehicleId: %s)", e.VehicleID)
+}
+
+// ChainNotSupported is ChainNotSupported(uint256 chainid).
+type ChainNotSupported struct {
+	ChainID *big.Int
+}
+
+func (e *ChainNotSupported) Error() string {
+	return fmt.Sprintf("ChainNotSupported(chainid: %s)", e.ChainID)
+}
+
+// InvalidManufacturerId is InvalidManufacturerId(uint256 id).
+type InvalidManufacturerId struct {
+	TokenID *big.Int
+}
+
+func (e *InvalidManufacturerId) Error() string {
+	return fmt.Sprintf("InvalidManufacturerId(id: %s)", e.TokenID)
+}
+
+// TableAlreadyExists is TableAlreadyExists(uint256 manufacturerId).
+type TableAlreadyExists struct {
+	ManufacturerID *big.Int
+}
+
+func (e *TableAlreadyExists) Error() string {
+	return fmt.Sprintf("TableAlreadyExists(manufacturerId: %s)", e.ManufacturerID)
+}
+
+// TableDoesNotExist is TableDoesNotExist(uint256 tableId).
+type TableDoesNotExist struct {
+	TableID *big.Int
+}
+
+func (e *TableDoesNotExist) Error() string {
+	return fmt.Sprintf("TableDoesNotExist(tableId: %s)", e.TableID)
+}
+
+// Revert is a revert with a reason string, encoded as Error(string).
+type Revert struct {
+	Reason string
+}
+
+func (e *Revert) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// Panic is a Solidity panic, encoded as Panic(uint256).
+type Panic struct {
+	Code *big.Int
+}
+
+func (e *Panic) Error() string {
+	return fmt.Sprintf("execution reverted: panic 0x%x", e.Code)
+}
+
+// Unknown is a revert whose payload does not match any error in the
+// DIMORegistry ABI.
+type Unknown struct {
+	Data []byte
+}
+
+func (e *Unknown) Error() string {
+	return fmt.Sprintf("execution reverted: unknown error 0x%x", e.Data)
+}
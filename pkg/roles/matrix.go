@@ -0,0 +1,197 @@
+package roles
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/logscan"
+)
+
+// Grant is a role currently held by an account.
+type Grant struct {
+	Account   common.Address
+	Role      [32]byte
+	GrantedBy common.Address
+	Block     uint64
+	TxHash    common.Hash
+}
+
+// Matrix is the current account × role assignment rebuilt from RoleGranted,
+// RoleRevoked and RoleAdminChanged events.
+type Matrix struct {
+	grants map[[32]byte]map[common.Address]Grant
+	admins map[[32]byte][32]byte
+}
+
+// NewMatrix returns a Matrix where no account holds any role.
+func NewMatrix() *Matrix {
+	return &Matrix{
+		grants: make(map[[32]byte]map[common.Address]Grant),
+		admins: make(map[[32]byte][32]byte),
+	}
+}
+
+// Apply updates m with a RoleGranted, RoleRevoked or RoleAdminChanged event.
+// Other events are ignored. Events must be applied in chain order.
+func (m *Matrix) Apply(ev contracts.RegistryEvent) {
+	switch ev := ev.(type) {
+	case *contracts.RegistryRoleGranted:
+		accounts, ok := m.grants[ev.Role]
+		if !ok {
+			accounts = make(map[common.Address]Grant)
+			m.grants[ev.Role] = accounts
+		}
+		accounts[ev.Account] = Grant{
+			Account:   ev.Account,
+			Role:      ev.Role,
+			GrantedBy: ev.Sender,
+			Block:     ev.Raw.BlockNumber,
+			TxHash:    ev.Raw.TxHash,
+		}
+	case *contracts.RegistryRoleRevoked:
+		delete(m.grants[ev.Role], ev.Account)
+	case *contracts.RegistryRoleAdminChanged:
+		m.admins[ev.Role] = ev.NewAdminRole
+	}
+}
+
+// HasRole reports whether account holds role.
+func (m *Matrix) HasRole(role [32]byte, account common.Address) bool {
+	_, ok := m.grants[role][account]
+	return ok
+}
+
+// AdminRole returns the role that administers role, DEFAULT_ADMIN_ROLE
+// unless it was changed.
+func (m *Matrix) AdminRole(role [32]byte) [32]byte {
+	return m.admins[role]
+}
+
+// Grants returns every current grant, sorted by role name and account.
+func (m *Matrix) Grants() []Grant {
+	var grants []Grant
+	for _, accounts := range m.grants {
+		for _, g := range accounts {
+			grants = append(grants, g)
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		ni, nj := NameOrHex(grants[i].Role), NameOrHex(grants[j].Role)
+		if ni != nj {
+			return ni < nj
+		}
+		return grants[i].Account.Hex() < grants[j].Account.Hex()
+	})
+	return grants
+}
+
+type grantJSON struct {
+	Account   common.Address `json:"account"`
+	Role      string         `json:"role"`
+	RoleHash  common.Hash    `json:"roleHash"`
+	AdminRole string         `json:"adminRole"`
+	GrantedBy common.Address `json:"grantedBy"`
+	Block     uint64         `json:"block"`
+	TxHash    common.Hash    `json:"txHash"`
+}
+
+// WriteJSON writes the grants as a JSON array, one object per grant with the
+// role name, its admin role and the grant's sender and transaction.
+func (m *Matrix) WriteJSON(w io.Writer) error {
+	grants := m.Grants()
+	out := make([]grantJSON, len(grants))
+	for i, g := range grants {
+		out[i] = grantJSON{
+			Account:   g.Account,
+			Role:      NameOrHex(g.Role),
+			RoleHash:  g.Role,
+			AdminRole: NameOrHex(m.AdminRole(g.Role)),
+			GrantedBy: g.GrantedBy,
+			Block:     g.Block,
+			TxHash:    g.TxHash,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// WriteCSV writes the matrix with one row per account and one column per
+// role held by at least one account. Cells hold the block of the grant, and
+// are empty when the account does not hold the role.
+func (m *Matrix) WriteCSV(w io.Writer) error {
+	grants := m.Grants()
+
+	var (
+		roleCols    []string
+		roleIndex   = make(map[string]int)
+		accounts    []common.Address
+		accountRows = make(map[common.Address][]string)
+	)
+	for _, g := range grants {
+		name := NameOrHex(g.Role)
+		if _, ok := roleIndex[name]; !ok {
+			roleIndex[name] = len(roleCols)
+			roleCols = append(roleCols, name)
+		}
+		if _, ok := accountRows[g.Account]; !ok {
+			accounts = append(accounts, g.Account)
+			accountRows[g.Account] = nil
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Hex() < accounts[j].Hex() })
+	for _, account := range accounts {
+		accountRows[account] = make([]string, len(roleCols))
+	}
+	for _, g := range grants {
+		accountRows[g.Account][roleIndex[NameOrHex(g.Role)]] = strconv.FormatUint(g.Block, 10)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"account"}, roleCols...)); err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if err := cw.Write(append([]string{account.Hex()}, accountRows[account]...)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Backend is the chain access needed by Load.
+type Backend = logscan.Backend
+
+// Range is the block range read by Load: FromBlock, usually the registry
+// deployment block, ToBlock, the head if zero, and ChunkSize, the number of
+// blocks per eth_getLogs call, 2000 by default.
+type Range = logscan.Range
+
+// Config configures Load.
+type Config struct {
+	// Registry is the DIMORegistry address.
+	Registry common.Address
+	Range
+}
+
+// Load replays the role events of the registry into a Matrix.
+func Load(ctx context.Context, backend Backend, cfg Config) (*Matrix, error) {
+	m := NewMatrix()
+	err := logscan.Scan(ctx, backend, cfg.Registry, cfg.Range, []string{"RoleGranted", "RoleRevoked", "RoleAdminChanged"},
+		func(ev contracts.RegistryEvent) error {
+			m.Apply(ev)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
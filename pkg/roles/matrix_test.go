@@ -0,0 +1,68 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+func TestLoad(t *testing.T) {
+	c := simtest.New(t)
+	registry := c.DeployEmitter(t)
+	from := c.Head(t) + 1
+
+	var (
+		admin  = common.HexToAddress("0xad")
+		minter = common.HexToAddress("0x01")
+		pairer = common.HexToAddress("0x02")
+	)
+	c.EmitEvent(t, registry, "RoleGranted", AdminRole, admin, admin)
+	c.EmitEvent(t, registry, "RoleGranted", MintVehicleRole, minter, admin)
+	c.Commit()
+	c.EmitEvent(t, registry, "RoleGranted", PairAdRole, pairer, admin)
+	c.EmitEvent(t, registry, "RoleGranted", MintVehicleRole, pairer, admin)
+	c.EmitEvent(t, registry, "RoleAdminChanged", MintVehicleRole, DefaultAdminRole, AdminRole)
+	c.Commit()
+	c.EmitEvent(t, registry, "RoleRevoked", MintVehicleRole, minter, admin)
+	c.Commit()
+
+	m, err := Load(context.Background(), c.Client, Config{Registry: registry, Range: Range{FromBlock: from, ChunkSize: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		role    [32]byte
+		account common.Address
+		want    bool
+	}{
+		{AdminRole, admin, true},
+		{MintVehicleRole, minter, false},
+		{MintVehicleRole, pairer, true},
+		{PairAdRole, pairer, true},
+		{PairAdRole, minter, false},
+	} {
+		if got := m.HasRole(tt.role, tt.account); got != tt.want {
+			t.Errorf("HasRole(%s, %s) = %t, want %t", NameOrHex(tt.role), tt.account, got, tt.want)
+		}
+	}
+	if m.AdminRole(MintVehicleRole) != AdminRole || m.AdminRole(PairAdRole) != DefaultAdminRole {
+		t.Errorf("admin roles = %s, %s, want ADMIN_ROLE, DEFAULT_ADMIN_ROLE", NameOrHex(m.AdminRole(MintVehicleRole)), NameOrHex(m.AdminRole(PairAdRole)))
+	}
+
+	var csv bytes.Buffer
+	if err := m.WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("account,%s,%s,%s\n%s,,%d,%d\n%s,%d,,\n",
+		NameOrHex(AdminRole), NameOrHex(MintVehicleRole), NameOrHex(PairAdRole),
+		pairer.Hex(), from+1, from+1, admin.Hex(), from)
+	if csv.String() != want {
+		t.Errorf("WriteCSV() =\n%s\nwant\n%s", csv.String(), want)
+	}
+}
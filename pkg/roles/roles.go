@@ -0,0 +1,132 @@
+// Package roles names the DimoAccessControl roles of contracts/shared/Roles.sol
+// and rebuilds who holds them from the registry's role events.
+package roles
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Roles of contracts/shared/Roles.sol, as passed to RegistryCaller.HasRole.
+var (
+	// Admin roles
+	DefaultAdminRole = [32]byte{}
+	AdminRole        = role("ADMIN_ROLE")
+	AdminClaimAdRole = role("ADMIN_CLAIM_AD_ROLE")
+
+	// Aftermarket Device roles
+	ClaimAdRole   = role("CLAIM_AD_ROLE")
+	PairAdRole    = role("PAIR_AD_ROLE")
+	UnpairAdRole  = role("UNPAIR_AD_ROLE")
+	SetAdInfoRole = role("SET_AD_INFO_ROLE")
+
+	// Manufacturer roles
+	MintManufacturerRole    = role("MINT_MANUFACTURER_ROLE")
+	SetManufacturerInfoRole = role("SET_MANUFACTURER_INFO_ROLE")
+
+	// Synthetic Device roles
+	MintSdRole    = role("MINT_SD_ROLE")
+	BurnSdRole    = role("BURN_SD_ROLE")
+	SetSdInfoRole = role("SET_SD_INFO_ROLE")
+
+	// Vehicle roles
+	MintVehicleRole    = role("MINT_VEHICLE_ROLE")
+	BurnVehicleRole    = role("BURN_VEHICLE_ROLE")
+	SetVehicleInfoRole = role("SET_VEHICLE_INFO_ROLE")
+
+	// Multiple Minter roles
+	MintVehicleSdRole = role("MINT_VEHICLE_SD_ROLE")
+
+	// Developer roles
+	DevSuperAdminRole          = role("DEV_SUPER_ADMIN_ROLE")
+	DevAdTransferRole          = role("DEV_AD_TRANSFER_ROLE")
+	DevAdUnclaimRole           = role("DEV_AD_UNCLAIM_ROLE")
+	DevAdUnpairRole            = role("DEV_AD_UNPAIR_ROLE")
+	DevRenameManufacturersRole = role("DEV_RENAME_MANUFACTURERS_ROLE")
+	DevAdPairRole              = role("DEV_AD_PAIR_ROLE")
+	DevVehicleBurnRole         = role("DEV_VEHICLE_BURN_ROLE")
+	DevAdBurnRole              = role("DEV_AD_BURN_ROLE")
+	DevSdBurnRole              = role("DEV_SD_BURN_ROLE")
+	DevChangeParentNode        = role("DEV_CHANGE_PARENT_NODE")
+	DevCacheEns                = role("DEV_CACHE_ENS")
+	DevRemoveAttr              = role("DEV_REMOVE_ATTR")
+	DevSetDd                   = role("DEV_SET_DD")
+	DevMigrateSdParents        = role("DEV_MIGRATE_SD_PARENTS")
+	DevSetStorageNodeId        = role("DEV_SET_STORAGE_NODE_ID")
+)
+
+// names maps every role to its Solidity name.
+var names = map[[32]byte]string{
+	DefaultAdminRole:           "DEFAULT_ADMIN_ROLE",
+	AdminRole:                  "ADMIN_ROLE",
+	AdminClaimAdRole:           "ADMIN_CLAIM_AD_ROLE",
+	ClaimAdRole:                "CLAIM_AD_ROLE",
+	PairAdRole:                 "PAIR_AD_ROLE",
+	UnpairAdRole:               "UNPAIR_AD_ROLE",
+	SetAdInfoRole:              "SET_AD_INFO_ROLE",
+	MintManufacturerRole:       "MINT_MANUFACTURER_ROLE",
+	SetManufacturerInfoRole:    "SET_MANUFACTURER_INFO_ROLE",
+	MintSdRole:                 "MINT_SD_ROLE",
+	BurnSdRole:                 "BURN_SD_ROLE",
+	SetSdInfoRole:              "SET_SD_INFO_ROLE",
+	MintVehicleRole:            "MINT_VEHICLE_ROLE",
+	BurnVehicleRole:            "BURN_VEHICLE_ROLE",
+	SetVehicleInfoRole:         "SET_VEHICLE_INFO_ROLE",
+	MintVehicleSdRole:          "MINT_VEHICLE_SD_ROLE",
+	DevSuperAdminRole:          "DEV_SUPER_ADMIN_ROLE",
+	DevAdTransferRole:          "DEV_AD_TRANSFER_ROLE",
+	DevAdUnclaimRole:           "DEV_AD_UNCLAIM_ROLE",
+	DevAdUnpairRole:            "DEV_AD_UNPAIR_ROLE",
+	DevRenameManufacturersRole: "DEV_RENAME_MANUFACTURERS_ROLE",
+	DevAdPairRole:              "DEV_AD_PAIR_ROLE",
+	DevVehicleBurnRole:         "DEV_VEHICLE_BURN_ROLE",
+	DevAdBurnRole:              "DEV_AD_BURN_ROLE",
+	DevSdBurnRole:              "DEV_SD_BURN_ROLE",
+	DevChangeParentNode:        "DEV_CHANGE_PARENT_NODE",
+	DevCacheEns:                "DEV_CACHE_ENS",
+	DevRemoveAttr:              "DEV_REMOVE_ATTR",
+	DevSetDd:                   "DEV_SET_DD",
+	DevMigrateSdParents:        "DEV_MIGRATE_SD_PARENTS",
+	DevSetStorageNodeId:        "DEV_SET_STORAGE_NODE_ID",
+}
+
+func role(name string) [32]byte {
+	return crypto.Keccak256Hash([]byte(name))
+}
+
+// Name returns the Solidity name of role, e.g. "MINT_VEHICLE_ROLE".
+func Name(role [32]byte) (string, bool) {
+	name, ok := names[role]
+	return name, ok
+}
+
+// NameOrHex returns the Solidity name of role, or its hex encoding for a role
+// that is not in Roles.sol.
+func NameOrHex(role [32]byte) string {
+	if name, ok := names[role]; ok {
+		return name
+	}
+	return common.Hash(role).Hex()
+}
+
+// ByName returns the role with the Solidity name name.
+func ByName(name string) ([32]byte, bool) {
+	for role, n := range names {
+		if n == name {
+			return role, true
+		}
+	}
+	return [32]byte{}, false
+}
+
+// All returns every role of Roles.sol, sorted by name.
+func All() [][32]byte {
+	all := make([][32]byte, 0, len(names))
+	for role := range names {
+		all = append(all, role)
+	}
+	sort.Slice(all, func(i, j int) bool { return names[all[i]] < names[all[j]] })
+	return all
+}
@@ -0,0 +1,67 @@
+package sdkeys
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const hardenedOffset = 0x80000000
+
+var errInvalidKey = errors.New("sdkeys: derived key is invalid, use another seed or path")
+
+// extendedKey is a BIP-32 extended private key.
+type extendedKey struct {
+	key       *big.Int
+	chainCode []byte
+}
+
+// masterKey returns the BIP-32 master key of seed.
+func masterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, errInvalidKey
+	}
+	return &extendedKey{key: key, chainCode: sum[32:]}, nil
+}
+
+// derive returns the private child key at path. Every index of path must be
+// hardened, since non-hardened children are never needed here.
+func (k *extendedKey) derive(path accounts.DerivationPath) (*extendedKey, error) {
+	n := crypto.S256().Params().N
+	for _, index := range path {
+		if index < hardenedOffset {
+			return nil, errors.New("sdkeys: only hardened derivation is supported")
+		}
+
+		data := make([]byte, 37)
+		copy(data[1:33], math.PaddedBigBytes(k.key, 32))
+		binary.BigEndian.PutUint32(data[33:], index)
+
+		mac := hmac.New(sha512.New, k.chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		il := new(big.Int).SetBytes(sum[:32])
+		if il.Cmp(n) >= 0 {
+			return nil, errInvalidKey
+		}
+		child := il.Add(il, k.key)
+		child.Mod(child, n)
+		if child.Sign() == 0 {
+			return nil, errInvalidKey
+		}
+		k = &extendedKey{key: child, chainCode: sum[32:]}
+	}
+	return k, nil
+}
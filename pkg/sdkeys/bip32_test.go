@@ -0,0 +1,95 @@
+package sdkeys
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// TestDerive checks the hardened chains of the BIP-32 test vectors.
+func TestDerive(t *testing.T) {
+	tests := []struct {
+		name      string
+		seed      string
+		path      accounts.DerivationPath
+		chainCode string
+		key       string
+	}{
+		{
+			"vector 1 m",
+			"000102030405060708090a0b0c0d0e0f",
+			nil,
+			"873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+			"e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		},
+		{
+			"vector 1 m/0H",
+			"000102030405060708090a0b0c0d0e0f",
+			accounts.DerivationPath{hardenedOffset},
+			"47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141",
+			"edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		},
+		{
+			"vector 3 m",
+			"4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+			nil,
+			"01d28a3e53cffa419ec122c968b3259e16b65076495494d97cae10bbfec3c36f",
+			"00ddb80b067e0d4993197fe10f2657a844a384589847602d56f0c629c81aae32",
+		},
+		{
+			"vector 3 m/0H",
+			"4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+			accounts.DerivationPath{hardenedOffset},
+			"e5fea12a97b927fc9dc3d2cb0d1ea1cf50aa5a1fdc1f933e8906bb38df3377bd",
+			"491f7a2eebc7b57028e0d3faa0acda02e75c33b03c48fb288c41e2ea44e1daef",
+		},
+		{
+			"vector 4 m/0H",
+			"3ddd5602285899a946114506157c7997e5444528f3003f6134712147db19b678",
+			accounts.DerivationPath{hardenedOffset},
+			"cdc0f06456a14876c898790e0b3b1a41c531170aec69da44ff7b7265bfe7743b",
+			"00d948e9261e41362a688b916f297121ba6bfb2274a3575ac0e456551dfd7f7e",
+		},
+		{
+			"vector 4 m/0H/1H",
+			"3ddd5602285899a946114506157c7997e5444528f3003f6134712147db19b678",
+			accounts.DerivationPath{hardenedOffset, hardenedOffset + 1},
+			"a48ee6674c5264a237703fd383bccd9fad4d9378ac98ab05e6e7029b06360c0d",
+			"3a2086edd7d9df86c3487a5905a1712a9aa664bce8cc268141e07549eaa8661d",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := hex.DecodeString(tt.seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			master, err := masterKey(seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			child, err := master.derive(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(child.chainCode); got != tt.chainCode {
+				t.Errorf("chain code = %s, want %s", got, tt.chainCode)
+			}
+			if got := hex.EncodeToString(math.PaddedBigBytes(child.key, 32)); got != tt.key {
+				t.Errorf("key = %s, want %s", got, tt.key)
+			}
+		})
+	}
+}
+
+func TestDeriveNotHardened(t *testing.T) {
+	master, err := masterKey(make([]byte, minSeedLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := master.derive(accounts.DerivationPath{hardenedOffset, 1}); err == nil {
+		t.Fatal("derived a non-hardened index")
+	}
+}
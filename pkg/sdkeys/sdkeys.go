@@ -0,0 +1,229 @@
+// Package sdkeys derives synthetic device keys from a master seed, so that
+// the key of every synthetic device an integration mints can be derived
+// again instead of being stored, and assembles the signed mint inputs.
+//
+// Keys are BIP-32 children of the seed. A synthetic device minted for an
+// existing vehicle is at
+//
+//	<base>/0'/<connection id>/<vehicle node>
+//
+// and one minted together with its vehicle, whose node is not known yet, at
+//
+//	<base>/1'/<connection id>/<external id>
+//
+// where the external id is any stable uint256 the integration keys its
+// vehicles by. Each uint256 is written as 9 hardened indices of 31 bits, most
+// significant first.
+package sdkeys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	contracts "github.com/DIMO-Network/dimo-identity"
+	"github.com/DIMO-Network/dimo-identity/pkg/eip712"
+)
+
+const (
+	vehicleBranch      = hardenedOffset + 0
+	vehicleAndSdBranch = hardenedOffset + 1
+
+	// A uint256 id is written as indicesPerId indices of bitsPerIndex bits.
+	indicesPerId = 9
+	bitsPerIndex = 31
+
+	minSeedLen = 16
+	maxSeedLen = 64
+)
+
+// DefaultBasePath is the path under which keys are derived unless Config
+// says otherwise.
+var DefaultBasePath = accounts.DerivationPath{hardenedOffset + 44, hardenedOffset + 60, hardenedOffset + 0}
+
+// ErrInvalidSeed is returned for a seed shorter than 16 or longer than 64
+// bytes.
+var ErrInvalidSeed = errors.New("sdkeys: seed must be 16 to 64 bytes")
+
+// Config configures a Deriver.
+type Config struct {
+	// Domain is the EIP-712 domain of the registry the devices are minted
+	// on.
+	Domain eip712.Domain
+	// BasePath is the path keys are derived under. Defaults to
+	// DefaultBasePath. Changing it changes every key.
+	BasePath accounts.DerivationPath
+	// Checker, if set, makes MintVehicleAndSdWithDdInput check the vehicle's
+	// device definition id before the device signs.
+	Checker DeviceDefinitionChecker
+}
+
+// DeviceDefinitionChecker verifies that a device definition id exists.
+// *devicedefinition.Checker satisfies it.
+type DeviceDefinitionChecker interface {
+	Check(ctx context.Context, manufacturerId *big.Int, id string) error
+}
+
+// Deriver derives synthetic device keys from a master seed. The seed must be
+// dedicated to synthetic devices: anyone holding it holds every device key.
+type Deriver struct {
+	master *extendedKey
+	cfg    Config
+}
+
+// NewDeriver returns a Deriver for seed, e.g. a BIP-39 seed or 32 random
+// bytes kept in a secret manager.
+func NewDeriver(seed []byte, cfg Config) (*Deriver, error) {
+	if len(seed) < minSeedLen || len(seed) > maxSeedLen {
+		return nil, ErrInvalidSeed
+	}
+	if cfg.BasePath == nil {
+		cfg.BasePath = DefaultBasePath
+	}
+	master, err := masterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Deriver{master: master, cfg: cfg}, nil
+}
+
+// Path returns the derivation path of the synthetic device minted under
+// connectionId for the existing vehicleNode.
+func (d *Deriver) Path(connectionId, vehicleNode *big.Int) (accounts.DerivationPath, error) {
+	return d.path(vehicleBranch, connectionId, vehicleNode)
+}
+
+// PathWithVehicle returns the derivation path of the synthetic device minted
+// under connectionId together with the vehicle the integration knows as
+// externalId.
+func (d *Deriver) PathWithVehicle(connectionId, externalId *big.Int) (accounts.DerivationPath, error) {
+	return d.path(vehicleAndSdBranch, connectionId, externalId)
+}
+
+// Key returns the key of the synthetic device minted under connectionId for
+// vehicleNode.
+func (d *Deriver) Key(connectionId, vehicleNode *big.Int) (*ecdsa.PrivateKey, error) {
+	path, err := d.Path(connectionId, vehicleNode)
+	if err != nil {
+		return nil, err
+	}
+	return d.key(path)
+}
+
+// KeyWithVehicle returns the key of the synthetic device minted under
+// connectionId together with the vehicle known as externalId.
+func (d *Deriver) KeyWithVehicle(connectionId, externalId *big.Int) (*ecdsa.PrivateKey, error) {
+	path, err := d.PathWithVehicle(connectionId, externalId)
+	if err != nil {
+		return nil, err
+	}
+	return d.key(path)
+}
+
+// MintSyntheticDeviceInput returns the input of MintSyntheticDeviceSign for
+// a synthetic device of vehicleNode under connectionId, with the device
+// address and signature filled in. vehicleOwnerSig is the vehicle owner's
+// signature of the same MintSyntheticDeviceSign message.
+func (d *Deriver) MintSyntheticDeviceInput(connectionId, vehicleNode *big.Int, vehicleOwnerSig []byte, attrInfo []contracts.AttributeInfoPair) (contracts.MintSyntheticDeviceInput, error) {
+	key, err := d.Key(connectionId, vehicleNode)
+	if err != nil {
+		return contracts.MintSyntheticDeviceInput{}, err
+	}
+	sig, err := eip712.Sign(key, d.cfg.Domain, &eip712.MintSyntheticDeviceSign{ConnectionId: connectionId, VehicleNode: vehicleNode})
+	if err != nil {
+		return contracts.MintSyntheticDeviceInput{}, err
+	}
+	return contracts.MintSyntheticDeviceInput{
+		ConnectionId:        connectionId,
+		VehicleNode:         vehicleNode,
+		SyntheticDeviceSig:  sig,
+		VehicleOwnerSig:     vehicleOwnerSig,
+		SyntheticDeviceAddr: crypto.PubkeyToAddress(key.PublicKey),
+		AttrInfoPairs:       attrInfo,
+	}, nil
+}
+
+// VehicleInput is the vehicle half of MintVehicleAndSdWithDdInput.
+// VehicleOwnerSig is Owner's signature of the
+// MintVehicleWithDeviceDefinitionSign message of the other fields, see
+// eip712.NewMintVehicleWithDeviceDefinitionSign.
+type VehicleInput struct {
+	ManufacturerNode   *big.Int
+	Owner              common.Address
+	DeviceDefinitionId string
+	AttrInfo           []contracts.AttributeInfoPair
+	VehicleOwnerSig    []byte
+}
+
+// MintVehicleAndSdWithDdInput returns the input of
+// MintVehicleAndSdWithDeviceDefinitionSign for vehicle and a synthetic device
+// under connectionId, with the device address and signature filled in.
+// externalId selects the device key, see PathWithVehicle. The device
+// definition id is checked first if Config has a Checker.
+func (d *Deriver) MintVehicleAndSdWithDdInput(ctx context.Context, connectionId, externalId *big.Int, vehicle VehicleInput, sdAttrInfo []contracts.AttributeInfoPair) (contracts.MintVehicleAndSdWithDdInput, error) {
+	if d.cfg.Checker != nil {
+		if err := d.cfg.Checker.Check(ctx, vehicle.ManufacturerNode, vehicle.DeviceDefinitionId); err != nil {
+			return contracts.MintVehicleAndSdWithDdInput{}, err
+		}
+	}
+	key, err := d.KeyWithVehicle(connectionId, externalId)
+	if err != nil {
+		return contracts.MintVehicleAndSdWithDdInput{}, err
+	}
+	sig, err := eip712.Sign(key, d.cfg.Domain, &eip712.MintVehicleAndSdSign{ConnectionId: connectionId})
+	if err != nil {
+		return contracts.MintVehicleAndSdWithDdInput{}, err
+	}
+	return contracts.MintVehicleAndSdWithDdInput{
+		ManufacturerNode:     vehicle.ManufacturerNode,
+		Owner:                vehicle.Owner,
+		DeviceDefinitionId:   vehicle.DeviceDefinitionId,
+		AttrInfoPairsVehicle: vehicle.AttrInfo,
+		ConnectionId:         connectionId,
+		VehicleOwnerSig:      vehicle.VehicleOwnerSig,
+		SyntheticDeviceSig:   sig,
+		SyntheticDeviceAddr:  crypto.PubkeyToAddress(key.PublicKey),
+		AttrInfoPairsDevice:  sdAttrInfo,
+	}, nil
+}
+
+func (d *Deriver) path(branch uint32, ids ...*big.Int) (accounts.DerivationPath, error) {
+	path := make(accounts.DerivationPath, 0, len(d.cfg.BasePath)+1+len(ids)*indicesPerId)
+	path = append(path, d.cfg.BasePath...)
+	path = append(path, branch)
+	for _, id := range ids {
+		if id == nil || id.Sign() < 0 || id.BitLen() > 256 {
+			return nil, fmt.Errorf("sdkeys: invalid id %v", id)
+		}
+		path = append(path, uint256Indices(id)...)
+	}
+	return path, nil
+}
+
+func (d *Deriver) key(path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	child, err := d.master.derive(path)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.ToECDSA(math.PaddedBigBytes(child.key, 32))
+}
+
+// uint256Indices splits v into hardened indices of 31 bits, the first one
+// holding the top 8 bits.
+func uint256Indices(v *big.Int) []uint32 {
+	mask := big.NewInt(1<<bitsPerIndex - 1)
+	indices := make([]uint32, indicesPerId)
+	rest := new(big.Int).Set(v)
+	for i := indicesPerId - 1; i >= 0; i-- {
+		indices[i] = hardenedOffset | uint32(new(big.Int).And(rest, mask).Uint64())
+		rest.Rsh(rest, bitsPerIndex)
+	}
+	return indices
+}
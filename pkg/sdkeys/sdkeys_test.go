@@ -0,0 +1,259 @@
+package sdkeys
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/eip712"
+)
+
+var (
+	testSeed   = common.FromHex("000102030405060708090a0b0c0d0e0f")
+	testDomain = eip712.NewDomain(big.NewInt(80002), common.HexToAddress("0x5eAA326fB2fc97fAcCe6A79A304876daD0F2e96c"))
+)
+
+func newTestDeriver(t *testing.T) *Deriver {
+	t.Helper()
+	d, err := NewDeriver(testSeed, Config{Domain: testDomain})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// hardened returns 9 hardened indices, all zero but the last ones.
+func hardened(last ...uint32) []uint32 {
+	indices := make([]uint32, indicesPerId)
+	for i := range indices {
+		indices[i] = hardenedOffset
+	}
+	for i, v := range last {
+		indices[indicesPerId-len(last)+i] |= v
+	}
+	return indices
+}
+
+func TestUint256Indices(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tests := []struct {
+		name string
+		v    *big.Int
+		want []uint32
+	}{
+		{"zero", big.NewInt(0), hardened()},
+		{"one", big.NewInt(1), hardened(1)},
+		{"31 bits", big.NewInt(1<<31 - 1), hardened(1<<31 - 1)},
+		{"32 bits", big.NewInt(1 << 31), hardened(1, 0)},
+		{"top bit", new(big.Int).Lsh(big.NewInt(1), 255), append([]uint32{hardenedOffset | 0x80}, hardened()[1:]...)},
+		{"max", max, []uint32{0x800000ff, ^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0), ^uint32(0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := uint256Indices(tt.v)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("indices = %x, want %x", got, tt.want)
+			}
+
+			// The indices hold the whole value, most significant first.
+			v := new(big.Int)
+			for _, index := range got {
+				v.Lsh(v, bitsPerIndex).Or(v, big.NewInt(int64(index&^hardenedOffset)))
+			}
+			if v.Cmp(tt.v) != 0 {
+				t.Fatalf("indices hold %s, want %s", v, tt.v)
+			}
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	d := newTestDeriver(t)
+
+	path, err := d.Path(big.NewInt(1), big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append(accounts.DerivationPath{}, DefaultBasePath...)
+	want = append(want, vehicleBranch)
+	want = append(want, hardened(1)...)
+	want = append(want, hardened(42)...)
+	if !reflect.DeepEqual(path, want) {
+		t.Errorf("Path = %s, want %s", path, want)
+	}
+
+	path, err = d.PathWithVehicle(big.NewInt(1), big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want[len(DefaultBasePath)] = vehicleAndSdBranch
+	if !reflect.DeepEqual(path, want) {
+		t.Errorf("PathWithVehicle = %s, want %s", path, want)
+	}
+
+	base := accounts.DerivationPath{hardenedOffset + 7}
+	custom, err := NewDeriver(testSeed, Config{BasePath: base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err = custom.Path(big.NewInt(1), big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 2+2*indicesPerId || path[0] != base[0] {
+		t.Errorf("Path with base %s = %s", base, path)
+	}
+}
+
+func TestPathInvalidId(t *testing.T) {
+	d := newTestDeriver(t)
+	for _, id := range []*big.Int{nil, big.NewInt(-1), new(big.Int).Lsh(big.NewInt(1), 256)} {
+		if _, err := d.Path(big.NewInt(1), id); err == nil {
+			t.Errorf("Path(1, %v) succeeded", id)
+		}
+		if _, err := d.Key(id, big.NewInt(1)); err == nil {
+			t.Errorf("Key(%v, 1) succeeded", id)
+		}
+	}
+}
+
+func TestNewDeriverInvalidSeed(t *testing.T) {
+	for _, n := range []int{0, minSeedLen - 1, maxSeedLen + 1} {
+		if _, err := NewDeriver(make([]byte, n), Config{}); !errors.Is(err, ErrInvalidSeed) {
+			t.Errorf("seed of %d bytes: error = %v, want %v", n, err, ErrInvalidSeed)
+		}
+	}
+}
+
+// TestKey checks that keys depend only on the seed and the ids, and pins the
+// address of one key so that a change of the derivation scheme is noticed.
+func TestKey(t *testing.T) {
+	d := newTestDeriver(t)
+	key := func(d *Deriver, connectionId, vehicleNode int64) common.Address {
+		t.Helper()
+		k, err := d.Key(big.NewInt(connectionId), big.NewInt(vehicleNode))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return crypto.PubkeyToAddress(k.PublicKey)
+	}
+
+	addr := key(d, 1, 42)
+	if want := common.HexToAddress("0xb4D56aa9C4d8fC50FD9c803e85A136C85893EC95"); addr != want {
+		t.Errorf("Key(1, 42) address = %s, want %s", addr, want)
+	}
+	if again := key(newTestDeriver(t), 1, 42); again != addr {
+		t.Errorf("Key(1, 42) of a second Deriver = %s, want %s", again, addr)
+	}
+	if other := key(d, 1, 43); other == addr {
+		t.Error("Key(1, 43) equals Key(1, 42)")
+	}
+	if other := key(d, 2, 42); other == addr {
+		t.Error("Key(2, 42) equals Key(1, 42)")
+	}
+
+	withVehicle, err := d.KeyWithVehicle(big.NewInt(1), big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(withVehicle.PublicKey) == addr {
+		t.Error("KeyWithVehicle(1, 42) equals Key(1, 42)")
+	}
+
+	other, err := NewDeriver(common.FromHex("0f0e0d0c0b0a09080706050403020100"), Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key(other, 1, 42) == addr {
+		t.Error("Key(1, 42) of another seed equals the first one")
+	}
+}
+
+func TestMintSyntheticDeviceInput(t *testing.T) {
+	d := newTestDeriver(t)
+	connectionId, vehicleNode := big.NewInt(1), big.NewInt(42)
+
+	in, err := d.MintSyntheticDeviceInput(connectionId, vehicleNode, []byte("owner"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := d.Key(connectionId, vehicleNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.PubkeyToAddress(key.PublicKey); in.SyntheticDeviceAddr != want {
+		t.Errorf("device address = %s, want %s", in.SyntheticDeviceAddr, want)
+	}
+	signer, err := eip712.Recover(testDomain, &eip712.MintSyntheticDeviceSign{ConnectionId: connectionId, VehicleNode: vehicleNode}, in.SyntheticDeviceSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer != in.SyntheticDeviceAddr {
+		t.Errorf("signed by %s, want %s", signer, in.SyntheticDeviceAddr)
+	}
+}
+
+func TestMintVehicleAndSdWithDdInput(t *testing.T) {
+	d := newTestDeriver(t)
+	connectionId, externalId := big.NewInt(1), big.NewInt(42)
+
+	in, err := d.MintVehicleAndSdWithDdInput(context.Background(), connectionId, externalId, VehicleInput{ManufacturerNode: big.NewInt(137)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := d.KeyWithVehicle(connectionId, externalId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.PubkeyToAddress(key.PublicKey); in.SyntheticDeviceAddr != want {
+		t.Errorf("device address = %s, want %s", in.SyntheticDeviceAddr, want)
+	}
+	signer, err := eip712.Recover(testDomain, &eip712.MintVehicleAndSdSign{ConnectionId: connectionId}, in.SyntheticDeviceSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer != in.SyntheticDeviceAddr {
+		t.Errorf("signed by %s, want %s", signer, in.SyntheticDeviceAddr)
+	}
+}
+
+// checkerFunc adapts a function to DeviceDefinitionChecker.
+type checkerFunc func(ctx context.Context, manufacturerId *big.Int, id string) error
+
+func (f checkerFunc) Check(ctx context.Context, manufacturerId *big.Int, id string) error {
+	return f(ctx, manufacturerId, id)
+}
+
+func TestMintVehicleAndSdWithDdInputChecked(t *testing.T) {
+	unknown := errors.New("unknown device definition")
+	var checked []string
+	d, err := NewDeriver(testSeed, Config{Domain: testDomain, Checker: checkerFunc(func(_ context.Context, manufacturerId *big.Int, id string) error {
+		checked = append(checked, manufacturerId.String()+"/"+id)
+		if id != "ford_bronco_2022" {
+			return unknown
+		}
+		return nil
+	})})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vehicle := VehicleInput{ManufacturerNode: big.NewInt(137), DeviceDefinitionId: "ford_bronco_2022"}
+	if _, err := d.MintVehicleAndSdWithDdInput(context.Background(), big.NewInt(1), big.NewInt(42), vehicle, nil); err != nil {
+		t.Fatal(err)
+	}
+	vehicle.DeviceDefinitionId = "ford_bronco_2023"
+	in, err := d.MintVehicleAndSdWithDdInput(context.Background(), big.NewInt(1), big.NewInt(42), vehicle, nil)
+	if !errors.Is(err, unknown) || in.SyntheticDeviceSig != nil {
+		t.Errorf("MintVehicleAndSdWithDdInput() = signature %x, error %v, want no signature and %v", in.SyntheticDeviceSig, err, unknown)
+	}
+	if want := []string{"137/ford_bronco_2022", "137/ford_bronco_2023"}; !reflect.DeepEqual(checked, want) {
+		t.Errorf("checked %v, want %v", checked, want)
+	}
+}
@@ -0,0 +1,373 @@
+// Package submitter sends DIMORegistry transactions from one key across
+// goroutines with a locally managed nonce sequence.
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+const (
+	defaultMaxRetries  = 3
+	defaultBumpPercent = 10
+
+	// pruneThreshold is the number of in-flight transactions above which
+	// Submit forgets the mined ones.
+	pruneThreshold = 64
+)
+
+// ErrUnknownTransaction is returned by SpeedUp and Cancel for a transaction
+// that is not in flight, either because it was not sent by this Submitter or
+// because it was already mined.
+var ErrUnknownTransaction = errors.New("submitter: transaction not in flight")
+
+// Backend is the chain access needed by a Submitter.
+type Backend interface {
+	bind.ContractBackend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// Config configures a Submitter.
+type Config struct {
+	// MaxRetries is the number of times a send is retried after a nonce
+	// error. Defaults to 3, -1 disables retries.
+	MaxRetries int
+	// BumpPercent is the minimum fee increase of a replacement transaction.
+	// Nodes reject replacements below 10%, which is the default.
+	BumpPercent int64
+}
+
+// InFlight is a transaction sent by the Submitter whose nonce was not seen
+// mined yet.
+type InFlight struct {
+	Tx     *types.Transaction
+	SentAt time.Time
+}
+
+// Submitter owns the nonce sequence of one sender. Every send goes through
+// Submit, SpeedUp or Cancel, which are safe for concurrent use. They only
+// hold the lock to reserve or look up a nonce and to record the result, so
+// sends run in parallel; SpeedUp and Cancel run one at a time.
+type Submitter struct {
+	backend Backend
+	opts    bind.TransactOpts
+	cfg     Config
+
+	// replaceMu serializes SpeedUp and Cancel.
+	replaceMu sync.Mutex
+
+	mu     sync.Mutex
+	synced bool
+	nonce  uint64
+	// released holds the nonces below nonce whose send failed, in
+	// increasing order, to be reserved again first.
+	released []uint64
+	inFlight map[uint64]InFlight
+}
+
+// New returns a Submitter sending with opts.From and opts.Signer. The Nonce
+// and Context of opts are ignored, the other fields are used as defaults for
+// every send.
+func New(backend Backend, opts *bind.TransactOpts, cfg Config) *Submitter {
+	switch {
+	case cfg.MaxRetries == 0:
+		cfg.MaxRetries = defaultMaxRetries
+	case cfg.MaxRetries < 0:
+		cfg.MaxRetries = 0
+	}
+	if cfg.BumpPercent == 0 {
+		cfg.BumpPercent = defaultBumpPercent
+	}
+	return &Submitter{
+		backend:  backend,
+		opts:     *opts,
+		cfg:      cfg,
+		inFlight: make(map[uint64]InFlight),
+	}
+}
+
+// Submit calls send with transaction options carrying the next nonce, e.g.
+//
+//	tx, err := s.Submit(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return registry.SetVehicleInfo(opts, vehicleId, attrInfo)
+//	})
+//
+// When the node answers "nonce too low" or "replacement transaction
+// underpriced", another transaction already uses the nonce: the sequence is
+// resynced from the pending state and send is retried. The nonce is
+// reserved while send runs and given back if it fails, so a reverting gas
+// estimation does not leave a gap.
+func (s *Submitter) Submit(ctx context.Context, send func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	if s.inFlightCount() > pruneThreshold {
+		if err := s.prune(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		nonce, err := s.reserve(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		opts := s.opts
+		opts.Context = ctx
+		opts.Nonce = new(big.Int).SetUint64(nonce)
+		tx, err := send(&opts)
+
+		s.mu.Lock()
+		switch {
+		case err == nil:
+			s.inFlight[nonce] = InFlight{Tx: tx, SentAt: time.Now()}
+		case isNonceError(err):
+			s.synced = false
+		default:
+			s.release(nonce)
+		}
+		s.mu.Unlock()
+
+		if err == nil {
+			return tx, nil
+		}
+		if !isNonceError(err) || attempt >= s.cfg.MaxRetries {
+			return nil, registryerrors.FromError(err)
+		}
+	}
+}
+
+// reserve returns the lowest released nonce, or the next one of the
+// sequence, resyncing it first if needed.
+func (s *Submitter) reserve(ctx context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.synced {
+		if err := s.resync(ctx); err != nil {
+			return 0, err
+		}
+	}
+	if len(s.released) > 0 {
+		nonce := s.released[0]
+		s.released = s.released[1:]
+		return nonce, nil
+	}
+	nonce := s.nonce
+	s.nonce++
+	return nonce, nil
+}
+
+// release gives back a reserved nonce whose send failed. The sequence shrinks
+// if the nonce, and the released ones below it, are at its end.
+func (s *Submitter) release(nonce uint64) {
+	if nonce >= s.nonce {
+		// Resynced since the nonce was reserved.
+		return
+	}
+	i := sort.Search(len(s.released), func(i int) bool { return s.released[i] >= nonce })
+	s.released = append(s.released[:i], append([]uint64{nonce}, s.released[i:]...)...)
+	for n := len(s.released); n > 0 && s.released[n-1] == s.nonce-1; n-- {
+		s.released = s.released[:n-1]
+		s.nonce--
+	}
+}
+
+// Resync reloads the nonce sequence from the sender's pending nonce. It is
+// useful after transactions were sent with the same key outside of the
+// Submitter.
+func (s *Submitter) Resync(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.resync(ctx)
+}
+
+// resync reloads the sequence and forgets the transactions already mined.
+func (s *Submitter) resync(ctx context.Context) error {
+	nonce, err := s.backend.PendingNonceAt(ctx, s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+	mined, err := s.backend.NonceAt(ctx, s.opts.From, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+	s.nonce = nonce
+	s.released = nil
+	s.synced = true
+	s.forget(mined)
+	return nil
+}
+
+// Pending forgets the transactions whose nonce was mined and returns the
+// others sent more than olderThan ago, all of them if olderThan is zero, in
+// nonce order. Those are the candidates for SpeedUp or Cancel.
+func (s *Submitter) Pending(ctx context.Context, olderThan time.Duration) ([]InFlight, error) {
+	mined, err := s.backend.NonceAt(ctx, s.opts.From, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forget(mined)
+
+	var out []InFlight
+	for _, f := range s.inFlight {
+		if olderThan > 0 && time.Since(f.SentAt) < olderThan {
+			continue
+		}
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tx.Nonce() < out[j].Tx.Nonce() })
+	return out, nil
+}
+
+func (s *Submitter) inFlightCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.inFlight)
+}
+
+// prune forgets the transactions whose nonce was mined.
+func (s *Submitter) prune(ctx context.Context) error {
+	mined, err := s.backend.NonceAt(ctx, s.opts.From, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get nonce: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forget(mined)
+	return nil
+}
+
+// forget drops the in-flight transactions with a nonce below mined.
+func (s *Submitter) forget(mined uint64) {
+	for nonce := range s.inFlight {
+		if nonce < mined {
+			delete(s.inFlight, nonce)
+		}
+	}
+}
+
+// SpeedUp replaces the in-flight tx with the same transaction at bumped fees.
+func (s *Submitter) SpeedUp(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	return s.replace(ctx, tx, tx.To(), tx.Value(), tx.Gas(), tx.Data())
+}
+
+// Cancel replaces the in-flight tx with an empty transfer to the sender at
+// bumped fees, so that the nonce is consumed without calling the registry.
+func (s *Submitter) Cancel(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	return s.replace(ctx, tx, &s.opts.From, new(big.Int), 21000, nil)
+}
+
+func (s *Submitter) replace(ctx context.Context, tx *types.Transaction, to *common.Address, value *big.Int, gas uint64, data []byte) (*types.Transaction, error) {
+	s.replaceMu.Lock()
+	defer s.replaceMu.Unlock()
+
+	if !s.isInFlight(tx) {
+		return nil, ErrUnknownTransaction
+	}
+
+	var head *types.Header
+	if tx.Type() != types.LegacyTxType {
+		var err error
+		if head, err = s.backend.HeaderByNumber(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var inner types.TxData
+	if head == nil || head.BaseFee == nil {
+		// Legacy transaction, or a chain without EIP-1559 fees, where the
+		// replacement bumps the fee cap of tx as its gas price.
+		price, err := s.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		inner = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: s.bump(tx.GasPrice(), price),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}
+	} else {
+		tip, err := s.backend.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, err
+		}
+		gasTipCap := s.bump(tx.GasTipCap(), tip)
+		feeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+		inner = &types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: gasTipCap,
+			GasFeeCap: s.bump(tx.GasFeeCap(), feeCap),
+			Gas:       gas,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		}
+	}
+
+	signed, err := s.opts.Signer(s.opts.From, types.NewTx(inner))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.backend.SendTransaction(ctx, signed); err != nil {
+		return nil, registryerrors.FromError(err)
+	}
+
+	// The nonce may have been forgotten as mined, or reused after a resync,
+	// while the lock was released; only a tx still in flight is replaced.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.inFlight[tx.Nonce()]; ok && current.Tx.Hash() == tx.Hash() {
+		s.inFlight[tx.Nonce()] = InFlight{Tx: signed, SentAt: time.Now()}
+	}
+	return signed, nil
+}
+
+// isInFlight reports whether tx is the in-flight transaction of its nonce.
+func (s *Submitter) isInFlight(tx *types.Transaction) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.inFlight[tx.Nonce()]
+	return ok && current.Tx.Hash() == tx.Hash()
+}
+
+// bump returns old increased by BumpPercent, or suggested if higher.
+func (s *Submitter) bump(old, suggested *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(old, big.NewInt(100+s.cfg.BumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(old) == 0 {
+		bumped.Add(bumped, big.NewInt(1))
+	}
+	if suggested.Cmp(bumped) > 0 {
+		return new(big.Int).Set(suggested)
+	}
+	return bumped
+}
+
+// isNonceError reports whether err means that the nonce is already used,
+// either by a mined transaction or by one in the node's pool.
+func isNonceError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") ||
+		strings.Contains(msg, "replacement transaction underpriced")
+}
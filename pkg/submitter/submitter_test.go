@@ -0,0 +1,360 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ Backend = simulated.Client(nil)
+
+func newSubmitter(t *testing.T, c *simtest.Chain, backend Backend, cfg Config) *Submitter {
+	t.Helper()
+	opts, err := bind.NewKeyedTransactorWithChainID(c.Key, simtest.ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(backend, opts, cfg)
+}
+
+// emit returns a send function making the emitter log without topics.
+func emit(c *simtest.Chain, emitter common.Address) func(*bind.TransactOpts) (*types.Transaction, error) {
+	contract := bind.NewBoundContract(emitter, abi.ABI{}, c.Client, c.Client, c.Client)
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.RawTransact(opts, []byte{0})
+	}
+}
+
+func mined(t *testing.T, c *simtest.Chain, tx *types.Transaction) bool {
+	t.Helper()
+	receipt, err := c.Client.TransactionReceipt(context.Background(), tx.Hash())
+	return err == nil && receipt.Status == types.ReceiptStatusSuccessful
+}
+
+func TestSubmitConcurrent(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	const n = 10
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		nonces []uint64
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx, err := s.Submit(ctx, send)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			nonces = append(nonces, tx.Nonce())
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	c.Commit()
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, nonce := range nonces {
+		if want := uint64(i + 1); nonce != want {
+			t.Fatalf("nonces = %v, want 1 to %d without gaps", nonces, n)
+		}
+	}
+	pending, err := s.Pending(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("%d transactions pending after they were mined", len(pending))
+	}
+}
+
+// TestSubmitSendsInParallel blocks a send until another one completes, which
+// deadlocks if sends run under the lock.
+func TestSubmitSendsInParallel(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	second := make(chan struct{})
+	first := make(chan error, 1)
+	go func() {
+		_, err := s.Submit(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			select {
+			case <-second:
+			case <-time.After(5 * time.Second):
+				return nil, errors.New("second send did not run")
+			}
+			return send(opts)
+		})
+		first <- err
+	}()
+
+	// Wait until the first send holds its nonce.
+	for s.reservedCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := s.Submit(ctx, send); err != nil {
+		t.Fatal(err)
+	}
+	close(second)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (s *Submitter) reservedCount() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nonce
+}
+
+func TestSubmitReleasesNonce(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	failed := errors.New("estimate gas: execution reverted")
+	if _, err := s.Submit(ctx, func(*bind.TransactOpts) (*types.Transaction, error) { return nil, failed }); !errors.Is(err, failed) {
+		t.Fatalf("Submit() = %v, want %v", err, failed)
+	}
+	tx, err := s.Submit(ctx, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 1 {
+		t.Errorf("nonce after a failed send = %d, want 1", tx.Nonce())
+	}
+}
+
+// TestSubmitNonceTooLow sends a transaction with the Submitter's key behind
+// its back, so that the next nonce is already mined.
+func TestSubmitNonceTooLow(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	send := emit(c, emitter)
+
+	for _, tt := range []struct {
+		name       string
+		maxRetries int
+		wantErr    bool
+	}{
+		{"resync", 0, false},
+		{"no retries", -1, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSubmitter(t, c, c.Client, Config{MaxRetries: tt.maxRetries})
+			if err := s.Resync(ctx); err != nil {
+				t.Fatal(err)
+			}
+			c.Emit(t, emitter, nil, nil)
+			c.Commit()
+			want, err := c.Client.NonceAt(ctx, c.From, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tx, err := s.Submit(ctx, send)
+			if tt.wantErr {
+				if err == nil || !isNonceError(err) {
+					t.Fatalf("Submit() = %v, want a nonce error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tx.Nonce() != want {
+				t.Errorf("nonce = %d, want %d", tx.Nonce(), want)
+			}
+			c.Commit()
+			if !mined(t, c, tx) {
+				t.Error("transaction not mined")
+			}
+		})
+	}
+}
+
+func TestSpeedUpAndCancel(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	slow, err := s.Submit(ctx, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unwanted, err := s.Submit(ctx, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fast, err := s.SpeedUp(ctx, slow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fast.Nonce() != slow.Nonce() || fast.GasTipCap().Cmp(slow.GasTipCap()) <= 0 || fast.GasFeeCap().Cmp(slow.GasFeeCap()) <= 0 {
+		t.Errorf("SpeedUp() = nonce %d, tip %s, fee cap %s, want nonce %d with higher fees than %s, %s",
+			fast.Nonce(), fast.GasTipCap(), fast.GasFeeCap(), slow.Nonce(), slow.GasTipCap(), slow.GasFeeCap())
+	}
+	cancel, err := s.Cancel(ctx, unwanted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cancel.To() != s.opts.From || len(cancel.Data()) != 0 || cancel.Nonce() != unwanted.Nonce() {
+		t.Errorf("Cancel() = %x to %s with nonce %d, want an empty transfer to %s with nonce %d",
+			cancel.Data(), cancel.To(), cancel.Nonce(), s.opts.From, unwanted.Nonce())
+	}
+	if _, err := s.SpeedUp(ctx, slow); !errors.Is(err, ErrUnknownTransaction) {
+		t.Errorf("SpeedUp() of a replaced transaction = %v, want %v", err, ErrUnknownTransaction)
+	}
+
+	c.Commit()
+	for _, tx := range []*types.Transaction{fast, cancel} {
+		if !mined(t, c, tx) {
+			t.Errorf("replacement %s not mined", tx.Hash())
+		}
+	}
+	for _, tx := range []*types.Transaction{slow, unwanted} {
+		if mined(t, c, tx) {
+			t.Errorf("replaced %s mined", tx.Hash())
+		}
+	}
+}
+
+// legacyBackend reports headers without a base fee, as on a chain without
+// EIP-1559.
+type legacyBackend struct {
+	Backend
+}
+
+func (b legacyBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	head, err := b.Backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	head = types.CopyHeader(head)
+	head.BaseFee = nil
+	return head, nil
+}
+
+func TestSpeedUpWithoutBaseFee(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+
+	tx, err := s.Submit(ctx, emit(c, emitter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.backend = legacyBackend{c.Client}
+	fast, err := s.SpeedUp(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fast.Type() != types.LegacyTxType || fast.GasPrice().Cmp(tx.GasFeeCap()) <= 0 {
+		t.Errorf("SpeedUp() = type %d, gas price %s, want a legacy transaction above %s", fast.Type(), fast.GasPrice(), tx.GasFeeCap())
+	}
+}
+
+// blockingBackend holds SuggestGasTipCap until release is closed.
+type blockingBackend struct {
+	Backend
+	called  chan struct{}
+	release chan struct{}
+}
+
+func (b blockingBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	close(b.called)
+	<-b.release
+	return b.Backend.SuggestGasTipCap(ctx)
+}
+
+// TestSpeedUpDoesNotBlockSubmit submits while a replacement waits on the
+// node, which deadlocks if replacements query it under the lock.
+func TestSpeedUpDoesNotBlockSubmit(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	slow, err := s.Submit(ctx, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := blockingBackend{Backend: c.Client, called: make(chan struct{}), release: make(chan struct{})}
+	s.backend = backend
+	fast := make(chan error, 1)
+	go func() {
+		_, err := s.SpeedUp(ctx, slow)
+		fast <- err
+	}()
+
+	<-backend.called
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.Submit(ctx, send)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Submit blocked by SpeedUp")
+	}
+	close(backend.release)
+	if err := <-fast; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSubmitPrunesMined(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	s := newSubmitter(t, c, c.Client, Config{})
+	send := emit(c, emitter)
+
+	for i := 0; i <= pruneThreshold; i++ {
+		if _, err := s.Submit(ctx, send); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.Commit()
+	if _, err := s.Submit(ctx, send); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.inFlightCount(); got != 1 {
+		t.Errorf("%d transactions in flight, want 1", got)
+	}
+}
@@ -0,0 +1,113 @@
+package tracker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Tracked is the persisted part of a tracked transaction.
+type Tracked struct {
+	Hash  common.Hash
+	From  common.Address
+	Nonce uint64
+}
+
+// Store persists the transactions being tracked.
+type Store interface {
+	Put(ctx context.Context, t Tracked) error
+	Delete(ctx context.Context, hash common.Hash) error
+	List(ctx context.Context) ([]Tracked, error)
+}
+
+// MemoryStore is a Store that does not survive the process. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	tracked map[common.Hash]Tracked
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tracked: make(map[common.Hash]Tracked)}
+}
+
+func (s *MemoryStore) Put(_ context.Context, t Tracked) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tracked[t.Hash] = t
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, hash common.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tracked, hash)
+	return nil
+}
+
+func (s *MemoryStore) List(context.Context) ([]Tracked, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Tracked, 0, len(s.tracked))
+	for _, t := range s.tracked {
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// SQLStore is a Store backed by the tracked_transactions table of a SQLite
+// database, such as one opened with indexer.Open.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore creates the tracked_transactions table if needed and returns a
+// SQLStore.
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS tracked_transactions (
+    hash         TEXT PRIMARY KEY,
+    from_address TEXT NOT NULL,
+    nonce        INTEGER NOT NULL
+)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+	return &SQLStore{db: db}, nil
+}
+
+func (s *SQLStore) Put(ctx context.Context, t Tracked) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO tracked_transactions (hash, from_address, nonce) VALUES (?, ?, ?)
+		 ON CONFLICT (hash) DO NOTHING`, t.Hash.Hex(), t.From.Hex(), t.Nonce)
+	return err
+}
+
+func (s *SQLStore) Delete(ctx context.Context, hash common.Hash) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM tracked_transactions WHERE hash = ?`, hash.Hex())
+	return err
+}
+
+func (s *SQLStore) List(ctx context.Context) ([]Tracked, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT hash, from_address, nonce FROM tracked_transactions`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Tracked
+	for rows.Next() {
+		var (
+			hash, from string
+			nonce      uint64
+		)
+		if err := rows.Scan(&hash, &from, &nonce); err != nil {
+			return nil, err
+		}
+		out = append(out, Tracked{Hash: common.HexToHash(hash), From: common.HexToAddress(from), Nonce: nonce})
+	}
+	return out, rows.Err()
+}
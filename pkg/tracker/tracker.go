@@ -0,0 +1,373 @@
+// Package tracker follows DIMORegistry transactions until they are confirmed,
+// reverted or dropped.
+package tracker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/registryerrors"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultDroppedAfter = 3
+)
+
+// ErrTransactionReverted is the error of a Reverted update when the revert
+// reason could not be recovered by replaying the transaction.
+var ErrTransactionReverted = errors.New("tracker: transaction reverted")
+
+// Status is the state of a tracked transaction.
+type Status int
+
+const (
+	// Pending means the transaction is not mined, or its block was
+	// reorganized out.
+	Pending Status = iota
+	// Mined means the transaction is in a block that does not have enough
+	// confirmations yet. The receipt status tells whether it will be
+	// Confirmed or Reverted.
+	Mined
+	// Confirmed means the transaction succeeded and its block reached the
+	// confirmation depth. It is final.
+	Confirmed
+	// Reverted means the transaction failed and its block reached the
+	// confirmation depth. It is final.
+	Reverted
+	// Dropped means the node forgot the transaction, or another transaction
+	// used its nonce. It is final.
+	Dropped
+)
+
+func (s Status) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Mined:
+		return "mined"
+	case Confirmed:
+		return "confirmed"
+	case Reverted:
+		return "reverted"
+	case Dropped:
+		return "dropped"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Final reports whether no update follows s.
+func (s Status) Final() bool {
+	return s == Confirmed || s == Reverted || s == Dropped
+}
+
+// Update is a status change of a tracked transaction. Receipt is set for
+// Mined, Confirmed and Reverted. Err is the decoded registry error of a
+// Reverted transaction.
+type Update struct {
+	Hash          common.Hash
+	Status        Status
+	Receipt       *types.Receipt
+	Confirmations uint64
+	Err           error
+}
+
+// Backend is the chain access needed by a Tracker.
+type Backend interface {
+	ethereum.BlockNumberReader
+	ethereum.TransactionReader
+	ethereum.ContractCaller
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
+// Config configures a Tracker.
+type Config struct {
+	// Confirmations is the number of blocks, including its own, a
+	// transaction's block needs before it is Confirmed or Reverted. Zero and
+	// one both finalize as soon as the transaction is mined.
+	Confirmations uint64
+	// DroppedAfter is the number of consecutive polls the node must not know
+	// a pending transaction before it is Dropped. Defaults to 3.
+	DroppedAfter int
+	// PollInterval is how often Run and Watch poll. Defaults to 5s.
+	PollInterval time.Duration
+}
+
+type entry struct {
+	Tracked
+	status    Status
+	blockHash common.Hash
+	misses    int
+}
+
+// Tracker polls the receipts of the tracked transactions. Tracked
+// transactions are persisted in a Store until they reach a final status, so
+// a new Tracker over the same Store resumes tracking them.
+type Tracker struct {
+	backend Backend
+	store   Store
+	cfg     Config
+
+	// pollMu serializes polls, which check the transactions without holding
+	// mu so that Track and Len do not wait for the node.
+	pollMu sync.Mutex
+
+	mu      sync.Mutex
+	entries map[common.Hash]*entry
+}
+
+// New returns a Tracker that resumes tracking the transactions in store.
+func New(ctx context.Context, backend Backend, store Store, cfg Config) (*Tracker, error) {
+	if cfg.DroppedAfter == 0 {
+		cfg.DroppedAfter = defaultDroppedAfter
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	tracked, err := store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tracked transactions: %w", err)
+	}
+	entries := make(map[common.Hash]*entry, len(tracked))
+	for _, t := range tracked {
+		entries[t.Hash] = &entry{Tracked: t}
+	}
+	return &Tracker{backend: backend, store: store, cfg: cfg, entries: entries}, nil
+}
+
+// Track starts tracking tx, which must be signed.
+func (t *Tracker) Track(ctx context.Context, tx *types.Transaction) error {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Errorf("failed to recover sender: %w", err)
+	}
+
+	tracked := Tracked{Hash: tx.Hash(), From: from, Nonce: tx.Nonce()}
+	if err := t.store.Put(ctx, tracked); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[tracked.Hash]; !ok {
+		t.entries[tracked.Hash] = &entry{Tracked: tracked}
+	}
+	return nil
+}
+
+// Len returns the number of transactions being tracked.
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.entries)
+}
+
+// Run polls until ctx is done and sends every update to sink.
+func (t *Tracker) Run(ctx context.Context, sink chan<- Update) error {
+	return t.Watch(ctx, func(u Update) {
+		select {
+		case sink <- u:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// Watch polls until ctx is done and calls fn with every update, from the
+// polling goroutine.
+func (t *Tracker) Watch(ctx context.Context, fn func(Update)) error {
+	ticker := time.NewTicker(t.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		updates, err := t.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range updates {
+			fn(u)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll checks every tracked transaction once and returns the status changes.
+// Transactions reaching a final status are removed from the Store.
+func (t *Tracker) Poll(ctx context.Context) ([]Update, error) {
+	t.pollMu.Lock()
+	defer t.pollMu.Unlock()
+
+	head, err := t.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	entries := make([]entry, 0, len(t.entries))
+	for _, e := range t.entries {
+		entries = append(entries, *e)
+	}
+	t.mu.Unlock()
+
+	nonces := make(map[common.Address]uint64)
+	var updates []Update
+	for i := range entries {
+		e := &entries[i]
+		u, changed, err := t.check(ctx, e, head, nonces)
+		if err != nil {
+			return updates, fmt.Errorf("failed to check transaction %s: %w", e.Hash.Hex(), err)
+		}
+		if err := t.apply(ctx, e, changed && u.Status.Final()); err != nil {
+			return updates, err
+		}
+		if changed {
+			updates = append(updates, u)
+		}
+	}
+	return updates, nil
+}
+
+// apply records the state of a checked copy of an entry, or forgets the
+// entry if final.
+func (t *Tracker) apply(ctx context.Context, e *entry, final bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if final {
+		if err := t.store.Delete(ctx, e.Hash); err != nil {
+			return err
+		}
+		delete(t.entries, e.Hash)
+		return nil
+	}
+	if current, ok := t.entries[e.Hash]; ok {
+		*current = *e
+	}
+	return nil
+}
+
+func (t *Tracker) check(ctx context.Context, e *entry, head uint64, nonces map[common.Address]uint64) (Update, bool, error) {
+	receipt, err := t.backend.TransactionReceipt(ctx, e.Hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return t.checkPending(ctx, e, head, nonces)
+	}
+	if err != nil {
+		return Update{Hash: e.Hash}, false, err
+	}
+	u, changed := t.checkMined(ctx, e, head, receipt)
+	return u, changed, nil
+}
+
+// checkMined handles a transaction with a receipt. changed is false while the
+// transaction stays Mined in the same block.
+func (t *Tracker) checkMined(ctx context.Context, e *entry, head uint64, receipt *types.Receipt) (u Update, changed bool) {
+	e.misses = 0
+	u = Update{Hash: e.Hash, Receipt: receipt}
+	if mined := receipt.BlockNumber.Uint64(); head >= mined {
+		u.Confirmations = head - mined + 1
+	}
+
+	if u.Confirmations >= t.cfg.Confirmations {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			u.Status = Reverted
+			u.Err = t.revertReason(ctx, e, receipt)
+			return u, true
+		}
+		u.Status = Confirmed
+		return u, true
+	}
+	if e.status == Mined && e.blockHash == receipt.BlockHash {
+		return u, false
+	}
+	e.status, e.blockHash = Mined, receipt.BlockHash
+	u.Status = Mined
+	return u, true
+}
+
+// checkPending handles a transaction without receipt: it may have been
+// reorganized out, replaced or dropped from the node's pool.
+func (t *Tracker) checkPending(ctx context.Context, e *entry, head uint64, nonces map[common.Address]uint64) (Update, bool, error) {
+	u := Update{Hash: e.Hash, Status: Pending}
+
+	nonce, ok := nonces[e.From]
+	if !ok {
+		var err error
+		nonce, err = t.backend.NonceAt(ctx, e.From, nil)
+		if err != nil {
+			return u, false, err
+		}
+		nonces[e.From] = nonce
+	}
+	if nonce > e.Nonce {
+		// The transaction may have been mined between the receipt and the
+		// nonce reads, look for its receipt again before dropping it.
+		receipt, err := t.backend.TransactionReceipt(ctx, e.Hash)
+		switch {
+		case err == nil:
+			u, changed := t.checkMined(ctx, e, head, receipt)
+			return u, changed, nil
+		case !errors.Is(err, ethereum.NotFound):
+			return u, false, err
+		}
+		u.Status = Dropped
+		return u, true, nil
+	}
+
+	_, _, err := t.backend.TransactionByHash(ctx, e.Hash)
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		e.misses++
+		if e.misses >= t.cfg.DroppedAfter {
+			u.Status = Dropped
+			return u, true, nil
+		}
+	case err != nil:
+		return u, false, err
+	default:
+		e.misses = 0
+	}
+
+	if e.status == Mined {
+		e.status, e.blockHash = Pending, common.Hash{}
+		return u, true, nil
+	}
+	return u, false, nil
+}
+
+// revertReason replays the transaction on the state before its block to
+// recover the registry error it reverted with.
+func (t *Tracker) revertReason(ctx context.Context, e *entry, receipt *types.Receipt) error {
+	tx, _, err := t.backend.TransactionByHash(ctx, e.Hash)
+	if err != nil {
+		return ErrTransactionReverted
+	}
+
+	msg := ethereum.CallMsg{
+		From:  e.From,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	if _, err := t.backend.CallContract(ctx, msg, parent); err != nil {
+		return registryerrors.FromError(err)
+	}
+	return ErrTransactionReverted
+}
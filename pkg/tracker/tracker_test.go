@@ -0,0 +1,355 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/DIMO-Network/dimo-identity/pkg/indexer"
+	"github.com/DIMO-Network/dimo-identity/pkg/internal/simtest"
+)
+
+var _ Backend = simulated.Client(nil)
+
+func newTracker(t *testing.T, backend Backend, store Store, cfg Config) *Tracker {
+	t.Helper()
+	tr, err := New(context.Background(), backend, store, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tr
+}
+
+// poll polls once and returns the status of every update by hash.
+func poll(t *testing.T, tr *Tracker) map[common.Hash]Update {
+	t.Helper()
+	updates, err := tr.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := make(map[common.Hash]Update, len(updates))
+	for _, u := range updates {
+		out[u.Hash] = u
+	}
+	return out
+}
+
+func track(t *testing.T, tr *Tracker, tx *types.Transaction) {
+	t.Helper()
+	if err := tr.Track(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// sign signs a call of the emitter that is not sent.
+func sign(t *testing.T, c *simtest.Chain, nonce uint64, to common.Address) *types.Transaction {
+	t.Helper()
+	tx, err := types.SignNewTx(c.Key, types.LatestSignerForChainID(simtest.ChainID), &types.DynamicFeeTx{
+		ChainID:   simtest.ChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		Gas:       100_000,
+		To:        &to,
+		Data:      []byte{0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// unchanged stands for no update in a list of expected statuses.
+const unchanged Status = -1
+
+func TestTrackerConfirmations(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		data          []byte
+		confirmations uint64
+		want          []Status
+	}{
+		{"success", []byte{0}, 3, []Status{Mined, unchanged, Confirmed}},
+		{"success at depth zero", []byte{0}, 0, []Status{Confirmed}},
+		{"revert", []byte{9}, 3, []Status{Mined, unchanged, Reverted}},
+		{"revert at depth zero", []byte{9}, 0, []Status{Reverted}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := simtest.New(t)
+			emitter := c.DeployEmitter(t)
+			tr := newTracker(t, c.Client, NewMemoryStore(), Config{Confirmations: tt.confirmations})
+
+			tx := c.Send(t, &emitter, tt.data)
+			track(t, tr, tx)
+			if got := poll(t, tr); len(got) != 0 {
+				t.Fatalf("updates of a pending transaction: %v", got)
+			}
+
+			for i, want := range tt.want {
+				c.Commit()
+				u, ok := poll(t, tr)[tx.Hash()]
+				if want == unchanged {
+					if ok {
+						t.Fatalf("block %d: %s, want no update", i+1, u.Status)
+					}
+					continue
+				}
+				if !ok {
+					t.Fatalf("block %d: no update, want %s", i+1, want)
+				}
+				if u.Status != want || u.Confirmations != uint64(i+1) {
+					t.Fatalf("block %d: %s with %d confirmations, want %s with %d", i+1, u.Status, u.Confirmations, want, i+1)
+				}
+				if (u.Status == Reverted) != (u.Err != nil) {
+					t.Errorf("block %d: %s with error %v", i+1, u.Status, u.Err)
+				}
+				// A second poll at the same head reports nothing.
+				if got := poll(t, tr); len(got) != 0 {
+					t.Fatalf("block %d: repeated updates %v", i+1, got)
+				}
+			}
+			if tr.Len() != 0 {
+				t.Errorf("%d transactions tracked after a final status", tr.Len())
+			}
+		})
+	}
+}
+
+func TestTrackerReorg(t *testing.T) {
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	tr := newTracker(t, c.Client, NewMemoryStore(), Config{Confirmations: 3})
+
+	parent, err := c.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := c.Send(t, &emitter, []byte{0})
+	track(t, tr, tx)
+	c.Commit()
+	first := poll(t, tr)[tx.Hash()]
+	if first.Status != Mined {
+		t.Fatalf("status = %s, want %s", first.Status, Mined)
+	}
+
+	// The transaction goes back to the pool.
+	if err := c.Fork(parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if u := poll(t, tr)[tx.Hash()]; u.Status != Pending || u.Receipt != nil {
+		t.Fatalf("after reorg: %s, want %s", u.Status, Pending)
+	}
+
+	c.Commit()
+	c.Commit()
+	u := poll(t, tr)[tx.Hash()]
+	if u.Status != Mined || u.Receipt.BlockHash == first.Receipt.BlockHash {
+		t.Fatalf("after re-mining: %s in %s, want %s in a new block", u.Status, u.Receipt.BlockHash, Mined)
+	}
+}
+
+func TestTrackerDropped(t *testing.T) {
+	ctx := context.Background()
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	tr := newTracker(t, c.Client, NewMemoryStore(), Config{DroppedAfter: 2})
+
+	nonce, err := c.Client.PendingNonceAt(ctx, c.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// replaced is never sent, another transaction mines its nonce.
+	replaced := sign(t, c, nonce, emitter)
+	// forgotten has a nonce gap, the node never sees it.
+	forgotten := sign(t, c, nonce+10, emitter)
+	track(t, tr, replaced)
+	track(t, tr, forgotten)
+
+	if got := poll(t, tr); len(got) != 0 {
+		t.Fatalf("first poll: %v, want no updates", got)
+	}
+	c.Send(t, &emitter, []byte{0})
+	c.Commit()
+
+	got := poll(t, tr)
+	for _, tx := range []*types.Transaction{replaced, forgotten} {
+		if u := got[tx.Hash()]; u.Status != Dropped {
+			t.Errorf("%s: %s, want %s", tx.Hash(), u.Status, Dropped)
+		}
+	}
+}
+
+// lateBackend does not find the receipt of a transaction on the first try,
+// as if it was mined between the receipt and the nonce reads of a poll.
+type lateBackend struct {
+	Backend
+	missed map[common.Hash]bool
+}
+
+func (b *lateBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if !b.missed[hash] {
+		b.missed[hash] = true
+		return nil, ethereum.NotFound
+	}
+	return b.Backend.TransactionReceipt(ctx, hash)
+}
+
+func TestTrackerMinedDuringPoll(t *testing.T) {
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	backend := &lateBackend{Backend: c.Client, missed: make(map[common.Hash]bool)}
+	tr := newTracker(t, backend, NewMemoryStore(), Config{})
+
+	tx := c.Send(t, &emitter, []byte{0})
+	track(t, tr, tx)
+	c.Commit()
+	if u := poll(t, tr)[tx.Hash()]; u.Status != Confirmed {
+		t.Fatalf("status = %s, want %s", u.Status, Confirmed)
+	}
+}
+
+func TestTrackerResume(t *testing.T) {
+	ctx := context.Background()
+	db, err := indexer.Open("file:" + filepath.Join(t.TempDir(), "tracker.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	sqlStore, err := NewSQLStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		store Store
+	}{
+		{"memory", NewMemoryStore()},
+		{"sql", sqlStore},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := simtest.New(t)
+			emitter := c.DeployEmitter(t)
+
+			tx := c.Send(t, &emitter, []byte{0})
+			track(t, newTracker(t, c.Client, tt.store, Config{Confirmations: 2}), tx)
+			// Tracking twice keeps one entry.
+			track(t, newTracker(t, c.Client, tt.store, Config{Confirmations: 2}), tx)
+			c.Commit()
+
+			tr := newTracker(t, c.Client, tt.store, Config{Confirmations: 2})
+			if tr.Len() != 1 {
+				t.Fatalf("resumed %d transactions, want 1", tr.Len())
+			}
+			if u := poll(t, tr)[tx.Hash()]; u.Status != Mined {
+				t.Fatalf("status = %s, want %s", u.Status, Mined)
+			}
+			c.Commit()
+			if u := poll(t, tr)[tx.Hash()]; u.Status != Confirmed {
+				t.Fatalf("status = %s, want %s", u.Status, Confirmed)
+			}
+
+			stored, err := tt.store.List(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stored) != 0 {
+				t.Errorf("store still has %v", stored)
+			}
+		})
+	}
+}
+
+func TestTrackerBackendError(t *testing.T) {
+	c := simtest.New(t)
+	failing := errors.New("connection refused")
+	tr := newTracker(t, errBackend{c.Client, failing}, NewMemoryStore(), Config{})
+	track(t, tr, sign(t, c, 0, common.Address{}))
+	if _, err := tr.Poll(context.Background()); !errors.Is(err, failing) {
+		t.Fatalf("Poll() = %v, want %v", err, failing)
+	}
+	if tr.Len() != 1 {
+		t.Errorf("%d transactions tracked, want 1", tr.Len())
+	}
+}
+
+type errBackend struct {
+	Backend
+	err error
+}
+
+func (b errBackend) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	return nil, b.err
+}
+
+// blockingBackend holds TransactionReceipt until release is closed.
+type blockingBackend struct {
+	Backend
+	called  chan struct{}
+	release chan struct{}
+}
+
+func (b blockingBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	select {
+	case b.called <- struct{}{}:
+	default:
+	}
+	<-b.release
+	return b.Backend.TransactionReceipt(ctx, hash)
+}
+
+// TestTrackerPollDoesNotBlock tracks while a poll waits on the node, which
+// deadlocks if polls query it under the lock.
+func TestTrackerPollDoesNotBlock(t *testing.T) {
+	c := simtest.New(t)
+	emitter := c.DeployEmitter(t)
+	backend := blockingBackend{Backend: c.Client, called: make(chan struct{}, 1), release: make(chan struct{})}
+	tr := newTracker(t, backend, NewMemoryStore(), Config{})
+
+	first := c.Send(t, &emitter, []byte{0})
+	track(t, tr, first)
+	c.Commit()
+	polled := make(chan map[common.Hash]Update, 1)
+	go func() {
+		updates, _ := tr.Poll(context.Background())
+		out := make(map[common.Hash]Update, len(updates))
+		for _, u := range updates {
+			out[u.Hash] = u
+		}
+		polled <- out
+	}()
+
+	<-backend.called
+	second := sign(t, c, 1, emitter)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := tr.Track(context.Background(), second); err != nil {
+			t.Error(err)
+		}
+		if tr.Len() != 2 {
+			t.Errorf("%d transactions tracked, want 2", tr.Len())
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Track blocked by Poll")
+	}
+	close(backend.release)
+	if u := (<-polled)[first.Hash()]; u.Status != Confirmed {
+		t.Fatalf("status = %s, want %s", u.Status, Confirmed)
+	}
+	if tr.Len() != 1 {
+		t.Errorf("%d transactions tracked after the poll, want 1", tr.Len())
+	}
+}
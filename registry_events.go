@@ -0,0 +1,364 @@
+package contracts
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RegistryEvent is a decoded DIMORegistry event. The concrete type is the
+// Registry<Event> struct of the event, e.g. *RegistryVehicleNodeMinted.
+type RegistryEvent interface {
+	// EventName is the name of the event in the registry ABI. Overloaded
+	// events are suffixed like their Parse method, e.g.
+	// AftermarketDeviceUnclaimed0.
+	EventName() string
+	// Log returns the log the event was decoded from.
+	Log() types.Log
+}
+
+// UnknownEventError is returned by ParseLog for a log whose first topic is
+// not a registry event. Topic is the zero hash for logs without topics.
+type UnknownEventError struct {
+	Topic common.Hash
+}
+
+func (e *UnknownEventError) Error() string {
+	if e.Topic == (common.Hash{}) {
+		return "unknown registry event: log has no topics"
+	}
+	return fmt.Sprintf("unknown registry event with topic %s", e.Topic.Hex())
+}
+
+type registryEventParser func(*RegistryFilterer, types.Log) (RegistryEvent, error)
+
+func eventParser[T RegistryEvent](parse func(*RegistryFilterer, types.Log) (T, error)) registryEventParser {
+	return func(f *RegistryFilterer, log types.Log) (RegistryEvent, error) {
+		ev, err := parse(f, log)
+		if err != nil {
+			return nil, err
+		}
+		return ev, nil
+	}
+}
+
+var registryEventParsers = map[string]registryEventParser{
+	"AftermarketDeviceAddressReset":         eventParser((*RegistryFilterer).ParseAftermarketDeviceAddressReset),
+	"AftermarketDeviceAttributeAdded":       eventParser((*RegistryFilterer).ParseAftermarketDeviceAttributeAdded),
+	"AftermarketDeviceAttributeSet":         eventParser((*RegistryFilterer).ParseAftermarketDeviceAttributeSet),
+	"AftermarketDeviceClaimed":              eventParser((*RegistryFilterer).ParseAftermarketDeviceClaimed),
+	"AftermarketDeviceIdProxySet":           eventParser((*RegistryFilterer).ParseAftermarketDeviceIdProxySet),
+	"AftermarketDeviceNodeBurned":           eventParser((*RegistryFilterer).ParseAftermarketDeviceNodeBurned),
+	"AftermarketDeviceNodeMinted":           eventParser((*RegistryFilterer).ParseAftermarketDeviceNodeMinted),
+	"AftermarketDevicePaired":               eventParser((*RegistryFilterer).ParseAftermarketDevicePaired),
+	"AftermarketDeviceTransferred":          eventParser((*RegistryFilterer).ParseAftermarketDeviceTransferred),
+	"AftermarketDeviceUnclaimed":            eventParser((*RegistryFilterer).ParseAftermarketDeviceUnclaimed),
+	"AftermarketDeviceUnclaimed0":           eventParser((*RegistryFilterer).ParseAftermarketDeviceUnclaimed0),
+	"AftermarketDeviceUnpaired":             eventParser((*RegistryFilterer).ParseAftermarketDeviceUnpaired),
+	"BeneficiarySet":                        eventParser((*RegistryFilterer).ParseBeneficiarySet),
+	"ConnectionsManagerSet":                 eventParser((*RegistryFilterer).ParseConnectionsManagerSet),
+	"ControllerSet":                         eventParser((*RegistryFilterer).ParseControllerSet),
+	"DeviceDefinitionDeleted":               eventParser((*RegistryFilterer).ParseDeviceDefinitionDeleted),
+	"DeviceDefinitionIdSet":                 eventParser((*RegistryFilterer).ParseDeviceDefinitionIdSet),
+	"DeviceDefinitionInserted":              eventParser((*RegistryFilterer).ParseDeviceDefinitionInserted),
+	"DeviceDefinitionTableCreated":          eventParser((*RegistryFilterer).ParseDeviceDefinitionTableCreated),
+	"DeviceDefinitionUpdated":               eventParser((*RegistryFilterer).ParseDeviceDefinitionUpdated),
+	"DimoCreditSet":                         eventParser((*RegistryFilterer).ParseDimoCreditSet),
+	"DimoStreamrEnsSet":                     eventParser((*RegistryFilterer).ParseDimoStreamrEnsSet),
+	"DimoStreamrNodeSet":                    eventParser((*RegistryFilterer).ParseDimoStreamrNodeSet),
+	"DimoTokenSet":                          eventParser((*RegistryFilterer).ParseDimoTokenSet),
+	"FoundationSet":                         eventParser((*RegistryFilterer).ParseFoundationSet),
+	"ManufacturerAttributeAdded":            eventParser((*RegistryFilterer).ParseManufacturerAttributeAdded),
+	"ManufacturerAttributeSet":              eventParser((*RegistryFilterer).ParseManufacturerAttributeSet),
+	"ManufacturerIdProxySet":                eventParser((*RegistryFilterer).ParseManufacturerIdProxySet),
+	"ManufacturerLicenseSet":                eventParser((*RegistryFilterer).ParseManufacturerLicenseSet),
+	"ManufacturerNodeMinted":                eventParser((*RegistryFilterer).ParseManufacturerNodeMinted),
+	"ManufacturerTableSet":                  eventParser((*RegistryFilterer).ParseManufacturerTableSet),
+	"ModuleAdded":                           eventParser((*RegistryFilterer).ParseModuleAdded),
+	"ModuleRemoved":                         eventParser((*RegistryFilterer).ParseModuleRemoved),
+	"ModuleUpdated":                         eventParser((*RegistryFilterer).ParseModuleUpdated),
+	"OperationCostSet":                      eventParser((*RegistryFilterer).ParseOperationCostSet),
+	"RoleAdminChanged":                      eventParser((*RegistryFilterer).ParseRoleAdminChanged),
+	"RoleGranted":                           eventParser((*RegistryFilterer).ParseRoleGranted),
+	"RoleRevoked":                           eventParser((*RegistryFilterer).ParseRoleRevoked),
+	"SacdSet":                               eventParser((*RegistryFilterer).ParseSacdSet),
+	"StorageNodeSet":                        eventParser((*RegistryFilterer).ParseStorageNodeSet),
+	"StreamRegistrySet":                     eventParser((*RegistryFilterer).ParseStreamRegistrySet),
+	"SubscribedToVehicleStream":             eventParser((*RegistryFilterer).ParseSubscribedToVehicleStream),
+	"SyntheticDeviceAttributeAdded":         eventParser((*RegistryFilterer).ParseSyntheticDeviceAttributeAdded),
+	"SyntheticDeviceAttributeSet":           eventParser((*RegistryFilterer).ParseSyntheticDeviceAttributeSet),
+	"SyntheticDeviceIdProxySet":             eventParser((*RegistryFilterer).ParseSyntheticDeviceIdProxySet),
+	"SyntheticDeviceNodeBurned":             eventParser((*RegistryFilterer).ParseSyntheticDeviceNodeBurned),
+	"SyntheticDeviceNodeMinted":             eventParser((*RegistryFilterer).ParseSyntheticDeviceNodeMinted),
+	"VehicleAttributeAdded":                 eventParser((*RegistryFilterer).ParseVehicleAttributeAdded),
+	"VehicleAttributeRemoved":               eventParser((*RegistryFilterer).ParseVehicleAttributeRemoved),
+	"VehicleAttributeSet":                   eventParser((*RegistryFilterer).ParseVehicleAttributeSet),
+	"VehicleIdProxySet":                     eventParser((*RegistryFilterer).ParseVehicleIdProxySet),
+	"VehicleNodeBurned":                     eventParser((*RegistryFilterer).ParseVehicleNodeBurned),
+	"VehicleNodeMinted":                     eventParser((*RegistryFilterer).ParseVehicleNodeMinted),
+	"VehicleNodeMintedWithDeviceDefinition": eventParser((*RegistryFilterer).ParseVehicleNodeMintedWithDeviceDefinition),
+	"VehicleStorageNodeIdSet":               eventParser((*RegistryFilterer).ParseVehicleStorageNodeIdSet),
+	"VehicleStreamSet":                      eventParser((*RegistryFilterer).ParseVehicleStreamSet),
+	"VehicleStreamUnset":                    eventParser((*RegistryFilterer).ParseVehicleStreamUnset),
+}
+
+var (
+	registryTopicsOnce sync.Once
+	registryTopics     map[string]common.Hash
+	registryTopicNames map[common.Hash]string
+	registryTopicsErr  error
+)
+
+func loadRegistryTopics() error {
+	registryTopicsOnce.Do(func() {
+		parsed, err := RegistryMetaData.GetAbi()
+		if err != nil {
+			registryTopicsErr = err
+			return
+		}
+		registryTopics = make(map[string]common.Hash, len(parsed.Events))
+		registryTopicNames = make(map[common.Hash]string, len(parsed.Events))
+		for name, ev := range parsed.Events {
+			registryTopics[name] = ev.ID
+			registryTopicNames[ev.ID] = name
+		}
+	})
+	return registryTopicsErr
+}
+
+// RegistryEventTopics returns the topic hash of every registry event keyed by
+// event name. The returned map is a copy.
+func RegistryEventTopics() (map[string]common.Hash, error) {
+	if err := loadRegistryTopics(); err != nil {
+		return nil, err
+	}
+	topics := make(map[string]common.Hash, len(registryTopics))
+	for name, topic := range registryTopics {
+		topics[name] = topic
+	}
+	return topics, nil
+}
+
+// RegistryEventName returns the name of the registry event with the given
+// topic hash.
+func RegistryEventName(topic common.Hash) (string, bool) {
+	if err := loadRegistryTopics(); err != nil {
+		return "", false
+	}
+	name, ok := registryTopicNames[topic]
+	return name, ok
+}
+
+// ParseLog decodes any registry log into its Registry<Event> struct, picking
+// the parser from the first topic. A log with an unknown or missing first
+// topic returns an *UnknownEventError.
+func (f *RegistryFilterer) ParseLog(log types.Log) (RegistryEvent, error) {
+	if err := loadRegistryTopics(); err != nil {
+		return nil, err
+	}
+	if len(log.Topics) == 0 {
+		return nil, &UnknownEventError{}
+	}
+	parse, ok := registryEventParsers[registryTopicNames[log.Topics[0]]]
+	if !ok {
+		return nil, &UnknownEventError{Topic: log.Topics[0]}
+	}
+	return parse(f, log)
+}
+
+func (e *RegistryAftermarketDeviceAddressReset) EventName() string {
+	return "AftermarketDeviceAddressReset"
+}
+func (e *RegistryAftermarketDeviceAddressReset) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceAttributeAdded) EventName() string {
+	return "AftermarketDeviceAttributeAdded"
+}
+func (e *RegistryAftermarketDeviceAttributeAdded) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceAttributeSet) EventName() string {
+	return "AftermarketDeviceAttributeSet"
+}
+func (e *RegistryAftermarketDeviceAttributeSet) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceClaimed) EventName() string { return "AftermarketDeviceClaimed" }
+func (e *RegistryAftermarketDeviceClaimed) Log() types.Log    { return e.Raw }
+
+func (e *RegistryAftermarketDeviceIdProxySet) EventName() string {
+	return "AftermarketDeviceIdProxySet"
+}
+func (e *RegistryAftermarketDeviceIdProxySet) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceNodeBurned) EventName() string {
+	return "AftermarketDeviceNodeBurned"
+}
+func (e *RegistryAftermarketDeviceNodeBurned) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceNodeMinted) EventName() string {
+	return "AftermarketDeviceNodeMinted"
+}
+func (e *RegistryAftermarketDeviceNodeMinted) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDevicePaired) EventName() string { return "AftermarketDevicePaired" }
+func (e *RegistryAftermarketDevicePaired) Log() types.Log    { return e.Raw }
+
+func (e *RegistryAftermarketDeviceTransferred) EventName() string {
+	return "AftermarketDeviceTransferred"
+}
+func (e *RegistryAftermarketDeviceTransferred) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceUnclaimed) EventName() string { return "AftermarketDeviceUnclaimed" }
+func (e *RegistryAftermarketDeviceUnclaimed) Log() types.Log    { return e.Raw }
+
+func (e *RegistryAftermarketDeviceUnclaimed0) EventName() string {
+	return "AftermarketDeviceUnclaimed0"
+}
+func (e *RegistryAftermarketDeviceUnclaimed0) Log() types.Log { return e.Raw }
+
+func (e *RegistryAftermarketDeviceUnpaired) EventName() string { return "AftermarketDeviceUnpaired" }
+func (e *RegistryAftermarketDeviceUnpaired) Log() types.Log    { return e.Raw }
+
+func (e *RegistryBeneficiarySet) EventName() string { return "BeneficiarySet" }
+func (e *RegistryBeneficiarySet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryConnectionsManagerSet) EventName() string { return "ConnectionsManagerSet" }
+func (e *RegistryConnectionsManagerSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryControllerSet) EventName() string { return "ControllerSet" }
+func (e *RegistryControllerSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDeviceDefinitionDeleted) EventName() string { return "DeviceDefinitionDeleted" }
+func (e *RegistryDeviceDefinitionDeleted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDeviceDefinitionIdSet) EventName() string { return "DeviceDefinitionIdSet" }
+func (e *RegistryDeviceDefinitionIdSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDeviceDefinitionInserted) EventName() string { return "DeviceDefinitionInserted" }
+func (e *RegistryDeviceDefinitionInserted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDeviceDefinitionTableCreated) EventName() string {
+	return "DeviceDefinitionTableCreated"
+}
+func (e *RegistryDeviceDefinitionTableCreated) Log() types.Log { return e.Raw }
+
+func (e *RegistryDeviceDefinitionUpdated) EventName() string { return "DeviceDefinitionUpdated" }
+func (e *RegistryDeviceDefinitionUpdated) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDimoCreditSet) EventName() string { return "DimoCreditSet" }
+func (e *RegistryDimoCreditSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDimoStreamrEnsSet) EventName() string { return "DimoStreamrEnsSet" }
+func (e *RegistryDimoStreamrEnsSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDimoStreamrNodeSet) EventName() string { return "DimoStreamrNodeSet" }
+func (e *RegistryDimoStreamrNodeSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryDimoTokenSet) EventName() string { return "DimoTokenSet" }
+func (e *RegistryDimoTokenSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryFoundationSet) EventName() string { return "FoundationSet" }
+func (e *RegistryFoundationSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerAttributeAdded) EventName() string { return "ManufacturerAttributeAdded" }
+func (e *RegistryManufacturerAttributeAdded) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerAttributeSet) EventName() string { return "ManufacturerAttributeSet" }
+func (e *RegistryManufacturerAttributeSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerIdProxySet) EventName() string { return "ManufacturerIdProxySet" }
+func (e *RegistryManufacturerIdProxySet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerLicenseSet) EventName() string { return "ManufacturerLicenseSet" }
+func (e *RegistryManufacturerLicenseSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerNodeMinted) EventName() string { return "ManufacturerNodeMinted" }
+func (e *RegistryManufacturerNodeMinted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryManufacturerTableSet) EventName() string { return "ManufacturerTableSet" }
+func (e *RegistryManufacturerTableSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryModuleAdded) EventName() string { return "ModuleAdded" }
+func (e *RegistryModuleAdded) Log() types.Log    { return e.Raw }
+
+func (e *RegistryModuleRemoved) EventName() string { return "ModuleRemoved" }
+func (e *RegistryModuleRemoved) Log() types.Log    { return e.Raw }
+
+func (e *RegistryModuleUpdated) EventName() string { return "ModuleUpdated" }
+func (e *RegistryModuleUpdated) Log() types.Log    { return e.Raw }
+
+func (e *RegistryOperationCostSet) EventName() string { return "OperationCostSet" }
+func (e *RegistryOperationCostSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryRoleAdminChanged) EventName() string { return "RoleAdminChanged" }
+func (e *RegistryRoleAdminChanged) Log() types.Log    { return e.Raw }
+
+func (e *RegistryRoleGranted) EventName() string { return "RoleGranted" }
+func (e *RegistryRoleGranted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryRoleRevoked) EventName() string { return "RoleRevoked" }
+func (e *RegistryRoleRevoked) Log() types.Log    { return e.Raw }
+
+func (e *RegistrySacdSet) EventName() string { return "SacdSet" }
+func (e *RegistrySacdSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryStorageNodeSet) EventName() string { return "StorageNodeSet" }
+func (e *RegistryStorageNodeSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryStreamRegistrySet) EventName() string { return "StreamRegistrySet" }
+func (e *RegistryStreamRegistrySet) Log() types.Log    { return e.Raw }
+
+func (e *RegistrySubscribedToVehicleStream) EventName() string { return "SubscribedToVehicleStream" }
+func (e *RegistrySubscribedToVehicleStream) Log() types.Log    { return e.Raw }
+
+func (e *RegistrySyntheticDeviceAttributeAdded) EventName() string {
+	return "SyntheticDeviceAttributeAdded"
+}
+func (e *RegistrySyntheticDeviceAttributeAdded) Log() types.Log { return e.Raw }
+
+func (e *RegistrySyntheticDeviceAttributeSet) EventName() string {
+	return "SyntheticDeviceAttributeSet"
+}
+func (e *RegistrySyntheticDeviceAttributeSet) Log() types.Log { return e.Raw }
+
+func (e *RegistrySyntheticDeviceIdProxySet) EventName() string { return "SyntheticDeviceIdProxySet" }
+func (e *RegistrySyntheticDeviceIdProxySet) Log() types.Log    { return e.Raw }
+
+func (e *RegistrySyntheticDeviceNodeBurned) EventName() string { return "SyntheticDeviceNodeBurned" }
+func (e *RegistrySyntheticDeviceNodeBurned) Log() types.Log    { return e.Raw }
+
+func (e *RegistrySyntheticDeviceNodeMinted) EventName() string { return "SyntheticDeviceNodeMinted" }
+func (e *RegistrySyntheticDeviceNodeMinted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleAttributeAdded) EventName() string { return "VehicleAttributeAdded" }
+func (e *RegistryVehicleAttributeAdded) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleAttributeRemoved) EventName() string { return "VehicleAttributeRemoved" }
+func (e *RegistryVehicleAttributeRemoved) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleAttributeSet) EventName() string { return "VehicleAttributeSet" }
+func (e *RegistryVehicleAttributeSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleIdProxySet) EventName() string { return "VehicleIdProxySet" }
+func (e *RegistryVehicleIdProxySet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleNodeBurned) EventName() string { return "VehicleNodeBurned" }
+func (e *RegistryVehicleNodeBurned) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleNodeMinted) EventName() string { return "VehicleNodeMinted" }
+func (e *RegistryVehicleNodeMinted) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleNodeMintedWithDeviceDefinition) EventName() string {
+	return "VehicleNodeMintedWithDeviceDefinition"
+}
+func (e *RegistryVehicleNodeMintedWithDeviceDefinition) Log() types.Log { return e.Raw }
+
+func (e *RegistryVehicleStorageNodeIdSet) EventName() string { return "VehicleStorageNodeIdSet" }
+func (e *RegistryVehicleStorageNodeIdSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleStreamSet) EventName() string { return "VehicleStreamSet" }
+func (e *RegistryVehicleStreamSet) Log() types.Log    { return e.Raw }
+
+func (e *RegistryVehicleStreamUnset) EventName() string { return "VehicleStreamUnset" }
+func (e *RegistryVehicleStreamUnset) Log() types.Log    { return e.Raw }
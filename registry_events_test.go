@@ -0,0 +1,158 @@
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// zeroLog returns a log of ev with zero arguments.
+func zeroLog(t *testing.T, ev abi.Event) types.Log {
+	t.Helper()
+	log := types.Log{Topics: []common.Hash{ev.ID}, BlockNumber: 42, Index: 3}
+	var (
+		values     []interface{}
+		nonIndexed abi.Arguments
+	)
+	for _, in := range ev.Inputs {
+		v := reflect.New(in.Type.GetType()).Elem().Interface()
+		if v == (*big.Int)(nil) {
+			v = new(big.Int)
+		}
+		if !in.Indexed {
+			values = append(values, v)
+			nonIndexed = append(nonIndexed, in)
+			continue
+		}
+		topics, err := abi.MakeTopics([]interface{}{v})
+		if err != nil {
+			t.Fatalf("%s: %v", ev.Name, err)
+		}
+		log.Topics = append(log.Topics, topics[0][0])
+	}
+	data, err := nonIndexed.Pack(values...)
+	if err != nil {
+		t.Fatalf("%s: %v", ev.Name, err)
+	}
+	log.Data = data
+	return log
+}
+
+// TestParseLog decodes a log of every event of the ABI, which checks that
+// each one has a parser returning its Registry<Event> struct.
+func TestParseLog(t *testing.T) {
+	parsed, err := RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewRegistryFilterer(common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, ev := range parsed.Events {
+		t.Run(name, func(t *testing.T) {
+			log := zeroLog(t, ev)
+			got, err := f.ParseLog(log)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.EventName() != name {
+				t.Errorf("EventName() = %s, want %s", got.EventName(), name)
+			}
+			if typ := reflect.TypeOf(got).Elem().Name(); typ != "Registry"+name {
+				t.Errorf("decoded into %s, want Registry%s", typ, name)
+			}
+			if !reflect.DeepEqual(got.Log(), log) {
+				t.Errorf("Log() = %+v, want %+v", got.Log(), log)
+			}
+		})
+	}
+	for name := range registryEventParsers {
+		if _, ok := parsed.Events[name]; !ok {
+			t.Errorf("parser for %s, which is not in the registry ABI", name)
+		}
+	}
+}
+
+func TestParseLogDecoded(t *testing.T) {
+	parsed, err := RegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := NewRegistryFilterer(common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := parsed.Events["VehicleAttributeSet"]
+	data, err := ev.Inputs.NonIndexed().Pack(big.NewInt(7), "Make", "Ford")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := f.ParseLog(types.Log{Topics: []common.Hash{ev.ID}, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, ok := got.(*RegistryVehicleAttributeSet)
+	if !ok {
+		t.Fatalf("ParseLog() = %T, want *RegistryVehicleAttributeSet", got)
+	}
+	if set.TokenId.Int64() != 7 || set.Attribute != "Make" || set.Info != "Ford" {
+		t.Errorf("ParseLog() = %+v", set)
+	}
+
+	// A known topic with malformed data fails to decode.
+	if _, err := f.ParseLog(types.Log{Topics: []common.Hash{ev.ID}, Data: data[:40]}); err == nil {
+		t.Error("ParseLog() of truncated data succeeded")
+	}
+}
+
+func TestParseLogUnknown(t *testing.T) {
+	f, err := NewRegistryFilterer(common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		log  types.Log
+		want common.Hash
+	}{
+		{"no topics", types.Log{}, common.Hash{}},
+		{"unknown topic", types.Log{Topics: []common.Hash{{1}}}, common.Hash{1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.ParseLog(tt.log)
+			var unknown *UnknownEventError
+			if !errors.As(err, &unknown) || unknown.Topic != tt.want {
+				t.Fatalf("ParseLog() error = %v, want UnknownEventError for %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryEventTopics(t *testing.T) {
+	topics, err := RegistryEventTopics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, topic := range topics {
+		if got, ok := RegistryEventName(topic); !ok || got != name {
+			t.Errorf("RegistryEventName(%s) = %s, %t, want %s", topic, got, ok, name)
+		}
+	}
+
+	// The returned map is a copy.
+	delete(topics, "VehicleNodeMinted")
+	if again, _ := RegistryEventTopics(); again["VehicleNodeMinted"] == (common.Hash{}) {
+		t.Error("RegistryEventTopics() shares its map with callers")
+	}
+	if _, ok := RegistryEventName(common.Hash{1}); ok {
+		t.Error("RegistryEventName() of an unknown topic succeeded")
+	}
+}